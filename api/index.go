@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"sync"
@@ -94,9 +95,21 @@ func initApp() {
 
 	log.Println("Initializing services and handlers...")
 	repositoryRepository := repository.NewRepository(DBpool)
-	userService := service.NewUserService(repositoryRepository, config)
+	mailerMailer := providers.NewMailer()
+	userService := service.NewUserService(repositoryRepository, config, mailerMailer)
 	userHandler := appHandler.NewUserHandler(userService)
-	caseService := service.NewCaseService(repositoryRepository)
+	pusherClient := providers.NewPusherClient(config)
+	savedSearchService := service.NewSavedSearchService(repositoryRepository, pusherClient)
+	redactor, err := providers.NewRedactor()
+	if err != nil {
+		initErr = err
+		log.Printf("ERROR: Failed to create redactor: %v", err)
+		return
+	}
+	caseService := service.NewCaseService(repositoryRepository, pusherClient, savedSearchService, redactor)
+	// The function may be frozen between requests; each batch commits on its
+	// own, so a later cold start picks up where this one stopped.
+	go caseService.RunRedactionBackfill(context.Background())
 	client, err := providers.NewS3Client(config)
 	if err != nil {
 		initErr = err
@@ -106,16 +119,30 @@ func initApp() {
 	presignClient := providers.NewPresignClient(client)
 	fileService := service.NewFileService(repositoryRepository, client, presignClient, config)
 	caseHandler := appHandler.NewCaseHandler(caseService, fileService)
-	quoteService := service.NewQuoteService(repositoryRepository)
+	quoteExpiryConfig, err := providers.NewQuoteExpiryConfig()
+	if err != nil {
+		initErr = err
+		log.Printf("ERROR: Failed to read quote expiry config: %v", err)
+		return
+	}
+	quoteService := service.NewQuoteService(repositoryRepository, pusherClient, quoteExpiryConfig)
 	quoteHandler := appHandler.NewQuoteHandler(quoteService)
-	marketplaceService := service.NewMarketplaceService(repositoryRepository)
+	marketplaceService := service.NewMarketplaceService(repositoryRepository, redactor)
 	marketplaceHandler := appHandler.NewMarketplaceHandler(marketplaceService)
-	pusherClient := providers.NewPusherClient(config)
-	paymentService := service.NewPaymentService(repositoryRepository, config, pusherClient)
+	paymentService := service.NewPaymentService(repositoryRepository, config, pusherClient, quoteExpiryConfig)
 	paymentHandler := appHandler.NewPaymentHandler(paymentService)
 	fileHandler := appHandler.NewFileHandler(fileService)
 	webhookHandler := appHandler.NewWebhookHandler(paymentService, config)
-
-	engine := routes.SetupRoutes(userHandler, caseHandler, quoteHandler, marketplaceHandler, paymentHandler, fileHandler, webhookHandler, config)
+	lawyerVerificationService := service.NewLawyerVerificationService(repositoryRepository, fileService)
+	lawyerVerificationHandler := appHandler.NewLawyerVerificationHandler(lawyerVerificationService)
+	adminService := service.NewAdminService(repositoryRepository)
+	adminHandler := appHandler.NewAdminHandler(adminService)
+	categoryService := service.NewCategoryService(repositoryRepository)
+	categoryHandler := appHandler.NewCategoryHandler(categoryService)
+	savedSearchHandler := appHandler.NewSavedSearchHandler(savedSearchService)
+	notificationService := service.NewNotificationService(repositoryRepository)
+	notificationHandler := appHandler.NewNotificationHandler(notificationService)
+
+	engine := routes.SetupRoutes(userHandler, caseHandler, quoteHandler, marketplaceHandler, paymentHandler, fileHandler, webhookHandler, lawyerVerificationHandler, adminHandler, categoryHandler, savedSearchHandler, notificationHandler, repositoryRepository, config)
 	router = engine
 }
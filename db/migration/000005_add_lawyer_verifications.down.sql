@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS lawyer_verification_documents;
+DROP TABLE IF EXISTS lawyer_verifications;
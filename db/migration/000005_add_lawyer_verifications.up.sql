@@ -0,0 +1,32 @@
+-- Lawyer verification requests (one row per submission, latest one wins)
+CREATE TABLE lawyer_verifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    lawyer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    jurisdiction VARCHAR(100),
+    bar_number VARCHAR(100),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    review_note TEXT,
+    reviewed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Credential documents uploaded for a verification request
+CREATE TABLE lawyer_verification_documents (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    verification_id UUID NOT NULL REFERENCES lawyer_verifications(id) ON DELETE CASCADE,
+    file_name VARCHAR(255) NOT NULL,
+    file_path VARCHAR(500) NOT NULL,
+    file_size BIGINT NOT NULL,
+    mime_type VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_lawyer_verifications_lawyer_id ON lawyer_verifications(lawyer_id);
+CREATE INDEX idx_lawyer_verifications_status ON lawyer_verifications(status);
+CREATE INDEX idx_lawyer_verification_documents_verification_id ON lawyer_verification_documents(verification_id);
+
+-- Existing lawyers have to go through review like new ones
+INSERT INTO lawyer_verifications (lawyer_id, jurisdiction, bar_number)
+SELECT id, jurisdiction, bar_number FROM users WHERE role = 'lawyer';
@@ -0,0 +1,19 @@
+-- Admin accounts are not deleted on rollback; change their role first
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM users WHERE role = 'admin') THEN
+        RAISE EXCEPTION 'cannot roll back while admin users exist, change their role first';
+    END IF;
+END $$;
+
+DROP TABLE IF EXISTS admin_actions;
+
+UPDATE quotes SET status = 'rejected' WHERE status = 'voided';
+ALTER TABLE quotes DROP CONSTRAINT IF EXISTS quotes_status_check;
+ALTER TABLE quotes ADD CONSTRAINT quotes_status_check CHECK (status IN ('proposed', 'accepted', 'rejected'));
+
+ALTER TABLE users DROP COLUMN IF EXISTS suspension_reason;
+ALTER TABLE users DROP COLUMN IF EXISTS suspended_at;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE users ADD CONSTRAINT users_role_check CHECK (role IN ('client', 'lawyer'));
@@ -0,0 +1,26 @@
+-- Admin role
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_check;
+ALTER TABLE users ADD CONSTRAINT users_role_check CHECK (role IN ('client', 'lawyer', 'admin'));
+
+-- Account suspension
+ALTER TABLE users ADD COLUMN suspended_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE users ADD COLUMN suspension_reason TEXT;
+
+-- Admins can void quotes
+ALTER TABLE quotes DROP CONSTRAINT IF EXISTS quotes_status_check;
+ALTER TABLE quotes ADD CONSTRAINT quotes_status_check CHECK (status IN ('proposed', 'accepted', 'rejected', 'voided'));
+
+-- Audit log of admin moderation actions
+CREATE TABLE admin_actions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    admin_id UUID NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    action VARCHAR(50) NOT NULL,
+    target_type VARCHAR(20) NOT NULL CHECK (target_type IN ('user', 'case', 'quote')),
+    target_id UUID NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_admin_actions_admin_id ON admin_actions(admin_id);
+CREATE INDEX idx_admin_actions_target ON admin_actions(target_type, target_id);
+CREATE INDEX idx_admin_actions_created_at ON admin_actions(created_at DESC);
@@ -0,0 +1,21 @@
+-- Every case status change, including the initial 'open'
+CREATE TABLE case_status_history (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    case_id UUID NOT NULL REFERENCES cases(id) ON DELETE CASCADE,
+    from_status VARCHAR(20),
+    to_status VARCHAR(20) NOT NULL,
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    actor_role VARCHAR(20) NOT NULL CHECK (actor_role IN ('client', 'lawyer', 'admin', 'system')),
+    reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_case_status_history_case_id ON case_status_history(case_id, created_at);
+
+-- Backfill: every existing case was opened by its client, and anything past
+-- 'open' is recorded as a single system transition since we don't know more.
+INSERT INTO case_status_history (case_id, from_status, to_status, actor_id, actor_role, created_at)
+SELECT id, NULL, 'open', client_id, 'client', created_at FROM cases;
+
+INSERT INTO case_status_history (case_id, from_status, to_status, actor_role, created_at)
+SELECT id, 'open', status, 'system', updated_at FROM cases WHERE status != 'open';
@@ -0,0 +1,15 @@
+-- Snapshot of a case's content before each client edit
+CREATE TABLE case_revisions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    case_id UUID NOT NULL REFERENCES cases(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    category VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL,
+    changed_fields TEXT[] NOT NULL,
+    edited_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE(case_id, revision)
+);
+
+CREATE INDEX idx_case_revisions_case_id ON case_revisions(case_id);
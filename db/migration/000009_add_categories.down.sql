@@ -0,0 +1,2 @@
+ALTER TABLE cases DROP CONSTRAINT IF EXISTS cases_category_fkey;
+DROP TABLE IF EXISTS categories;
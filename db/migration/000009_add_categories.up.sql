@@ -0,0 +1,74 @@
+-- Managed case category taxonomy
+CREATE TABLE categories (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    slug VARCHAR(100) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    parent_id UUID REFERENCES categories(id) ON DELETE SET NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_categories_parent_id ON categories(parent_id);
+
+INSERT INTO categories (slug, name) VALUES
+    ('family-law', 'Family Law'),
+    ('employment', 'Employment'),
+    ('property', 'Property & Real Estate'),
+    ('criminal', 'Criminal'),
+    ('immigration', 'Immigration'),
+    ('corporate', 'Corporate & Commercial'),
+    ('intellectual-property', 'Intellectual Property'),
+    ('personal-injury', 'Personal Injury'),
+    ('wills-and-estates', 'Wills & Estates'),
+    ('debt-and-bankruptcy', 'Debt & Bankruptcy'),
+    ('other', 'Other');
+
+INSERT INTO categories (slug, name, parent_id)
+SELECT v.slug, v.name, p.id
+FROM (VALUES
+    ('divorce', 'Divorce', 'family-law'),
+    ('child-custody', 'Child Custody', 'family-law'),
+    ('tenancy', 'Tenancy', 'property'),
+    ('wrongful-dismissal', 'Wrongful Dismissal', 'employment'),
+    ('employment-contracts', 'Employment Contracts', 'employment')
+) AS v(slug, name, parent_slug)
+JOIN categories p ON p.slug = v.parent_slug;
+
+-- Map existing free-text categories onto slugs: exact slug or name matches
+-- first, then known aliases, and anything left over becomes 'other'. Case
+-- revisions are mapped the same way so they keep the category they had.
+CREATE FUNCTION pg_temp.category_slug(raw TEXT) RETURNS TEXT AS $$
+    SELECT COALESCE(
+        (SELECT cat.slug FROM categories cat
+         WHERE lower(trim(raw)) IN (cat.slug, lower(cat.name), replace(cat.slug, '-', ' '))
+         LIMIT 1),
+        CASE
+            WHEN lower(raw) LIKE '%divorce%' THEN 'divorce'
+            WHEN lower(raw) LIKE '%custody%' THEN 'child-custody'
+            WHEN lower(raw) LIKE '%family%' THEN 'family-law'
+            WHEN lower(raw) LIKE '%tenan%' OR lower(raw) LIKE '%landlord%' OR lower(raw) LIKE '%rental%' THEN 'tenancy'
+            WHEN lower(raw) LIKE '%property%' OR lower(raw) LIKE '%real estate%' THEN 'property'
+            WHEN lower(raw) LIKE '%dismiss%' THEN 'wrongful-dismissal'
+            WHEN lower(raw) LIKE '%employ%' OR lower(raw) LIKE '%labour%' OR lower(raw) LIKE '%labor%' THEN 'employment'
+            WHEN lower(raw) LIKE '%criminal%' THEN 'criminal'
+            WHEN lower(raw) LIKE '%immigra%' OR lower(raw) LIKE '%visa%' THEN 'immigration'
+            WHEN lower(raw) LIKE '%corporate%' OR lower(raw) LIKE '%business%' OR lower(raw) LIKE '%commercial%' THEN 'corporate'
+            WHEN lower(raw) LIKE '%intellectual%' OR lower(raw) LIKE '%trademark%' OR lower(raw) LIKE '%patent%' OR lower(raw) LIKE '%copyright%' THEN 'intellectual-property'
+            WHEN lower(raw) LIKE '%injury%' OR lower(raw) LIKE '%accident%' THEN 'personal-injury'
+            WHEN lower(raw) LIKE '%will%' OR lower(raw) LIKE '%estate%' OR lower(raw) LIKE '%probate%' THEN 'wills-and-estates'
+            WHEN lower(raw) LIKE '%debt%' OR lower(raw) LIKE '%bankrupt%' THEN 'debt-and-bankruptcy'
+            ELSE 'other'
+        END
+    );
+$$ LANGUAGE SQL STABLE;
+
+UPDATE cases
+SET category = pg_temp.category_slug(category)
+WHERE category NOT IN (SELECT slug FROM categories);
+
+UPDATE case_revisions
+SET category = pg_temp.category_slug(category)
+WHERE category NOT IN (SELECT slug FROM categories);
+
+ALTER TABLE cases ADD CONSTRAINT cases_category_fkey FOREIGN KEY (category) REFERENCES categories(slug) ON UPDATE CASCADE;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_cases_search;
+DROP FUNCTION IF EXISTS case_search_vector(TEXT, TEXT);
+ALTER TABLE cases DROP COLUMN IF EXISTS search_document;
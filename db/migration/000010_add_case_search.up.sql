@@ -0,0 +1,20 @@
+-- Anonymized text that marketplace search indexes, written by the app on
+-- create/update so contact details never reach the index.
+ALTER TABLE cases ADD COLUMN search_document TEXT NOT NULL DEFAULT '';
+
+-- Backfill with the same redaction the app applies.
+UPDATE cases
+SET search_document = replace(
+    regexp_replace(
+        regexp_replace(description, '[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}', '[email redacted]', 'g'),
+        '(\+?1[-.[:space:]]?)?\(?[0-9]{3}\)?[-.[:space:]]?[0-9]{3}[-.[:space:]]?[0-9]{4}', '[phone redacted]', 'g'),
+    '@', '[at]');
+
+-- Title matches rank above description matches.
+CREATE FUNCTION case_search_vector(title TEXT, document TEXT) RETURNS tsvector
+LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$
+    SELECT setweight(to_tsvector('english', coalesce(title, '')), 'A')
+        || setweight(to_tsvector('english', coalesce(document, '')), 'B')
+$$;
+
+CREATE INDEX idx_cases_search ON cases USING GIN (case_search_vector(title, search_document));
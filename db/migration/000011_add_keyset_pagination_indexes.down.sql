@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_quotes_lawyer_id_created_at;
+DROP INDEX IF EXISTS idx_cases_open_created_at;
+DROP INDEX IF EXISTS idx_cases_client_id_created_at;
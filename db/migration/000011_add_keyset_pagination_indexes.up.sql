@@ -0,0 +1,4 @@
+-- Back the (created_at, id) keyset pagination used by case and quote listings.
+CREATE INDEX idx_cases_client_id_created_at ON cases(client_id, created_at DESC, id DESC);
+CREATE INDEX idx_cases_open_created_at ON cases(created_at DESC, id DESC) WHERE status = 'open';
+CREATE INDEX idx_quotes_lawyer_id_created_at ON quotes(lawyer_id, created_at DESC, id DESC);
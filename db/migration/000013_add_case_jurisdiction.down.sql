@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_cases_jurisdiction;
+ALTER TABLE cases DROP COLUMN IF EXISTS jurisdiction;
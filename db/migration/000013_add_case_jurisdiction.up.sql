@@ -0,0 +1,5 @@
+-- Jurisdiction the client needs advice in. Cases posted before this column
+-- existed stay NULL and remain visible to lawyers in every jurisdiction.
+ALTER TABLE cases ADD COLUMN jurisdiction VARCHAR(100);
+
+CREATE INDEX idx_cases_jurisdiction ON cases(lower(jurisdiction));
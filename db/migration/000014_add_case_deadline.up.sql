@@ -0,0 +1,4 @@
+-- Optional date by which the client needs the matter handled
+ALTER TABLE cases ADD COLUMN deadline TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_cases_deadline ON cases(deadline) WHERE status = 'open';
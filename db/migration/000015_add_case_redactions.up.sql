@@ -0,0 +1,12 @@
+-- What the marketplace redaction removed from each case description.
+-- Only the detector and character offsets are kept, never the matched text.
+CREATE TABLE case_redactions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    case_id UUID NOT NULL REFERENCES cases(id) ON DELETE CASCADE,
+    detector VARCHAR(50) NOT NULL,
+    start_offset INTEGER NOT NULL,
+    end_offset INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_case_redactions_case_id ON case_redactions(case_id);
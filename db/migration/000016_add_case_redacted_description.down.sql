@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS case_manual_redactions;
+ALTER TABLE cases DROP COLUMN IF EXISTS redacted_at;
+ALTER TABLE cases RENAME COLUMN redacted_description TO search_document;
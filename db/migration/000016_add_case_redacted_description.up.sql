@@ -0,0 +1,17 @@
+-- The marketplace now reads the stored redaction instead of redacting on
+-- every request, so the search document becomes the redacted description.
+ALTER TABLE cases RENAME COLUMN search_document TO redacted_description;
+
+-- Set when the app last redacted the description. Rows redacted by the SQL
+-- backfill in 000010 stay NULL and are redacted on read until next edited.
+ALTER TABLE cases ADD COLUMN redacted_at TIMESTAMP WITH TIME ZONE;
+
+-- Text the client asked to hide from the marketplace on top of the detectors
+CREATE TABLE case_manual_redactions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    case_id UUID NOT NULL REFERENCES cases(id) ON DELETE CASCADE,
+    phrase TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_case_manual_redactions_case_id ON case_manual_redactions(case_id);
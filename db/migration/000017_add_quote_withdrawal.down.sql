@@ -0,0 +1,3 @@
+UPDATE quotes SET status = 'rejected' WHERE status = 'withdrawn';
+ALTER TABLE quotes DROP CONSTRAINT IF EXISTS quotes_status_check;
+ALTER TABLE quotes ADD CONSTRAINT quotes_status_check CHECK (status IN ('proposed', 'accepted', 'rejected', 'voided'));
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS case_views;
+DROP TABLE IF EXISTS quote_revisions;
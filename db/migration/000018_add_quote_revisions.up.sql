@@ -0,0 +1,21 @@
+-- Terms of a quote before each lawyer edit
+CREATE TABLE quote_revisions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    quote_id UUID NOT NULL REFERENCES quotes(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    amount NUMERIC(10, 2) NOT NULL,
+    expected_days INTEGER NOT NULL,
+    note TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE(quote_id, revision)
+);
+
+CREATE INDEX idx_quote_revisions_quote_id ON quote_revisions(quote_id);
+
+-- When each user last opened a case, to flag quotes changed since
+CREATE TABLE case_views (
+    case_id UUID NOT NULL REFERENCES cases(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    viewed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (case_id, user_id)
+);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_quotes_proposed_valid_until;
+
+UPDATE quotes SET status = 'rejected' WHERE status = 'expired';
+ALTER TABLE quotes DROP CONSTRAINT IF EXISTS quotes_status_check;
+ALTER TABLE quotes ADD CONSTRAINT quotes_status_check CHECK (status IN ('proposed', 'accepted', 'rejected', 'voided', 'withdrawn'));
+
+ALTER TABLE quotes DROP COLUMN IF EXISTS valid_until;
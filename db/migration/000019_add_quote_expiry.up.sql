@@ -0,0 +1,8 @@
+-- Proposed quotes lapse after valid_until. Quotes created before this
+-- column existed have none and expire by the default validity window.
+ALTER TABLE quotes ADD COLUMN valid_until TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE quotes DROP CONSTRAINT IF EXISTS quotes_status_check;
+ALTER TABLE quotes ADD CONSTRAINT quotes_status_check CHECK (status IN ('proposed', 'accepted', 'rejected', 'voided', 'withdrawn', 'expired'));
+
+CREATE INDEX idx_quotes_proposed_valid_until ON quotes(valid_until) WHERE status = 'proposed';
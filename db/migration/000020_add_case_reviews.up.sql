@@ -0,0 +1,12 @@
+-- Client rating of the engaged lawyer once a case is closed
+CREATE TABLE case_reviews (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    case_id UUID NOT NULL UNIQUE REFERENCES cases(id) ON DELETE CASCADE,
+    lawyer_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    client_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rating INTEGER NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    comment TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_case_reviews_lawyer_id ON case_reviews(lawyer_id);
@@ -0,0 +1,6 @@
+UPDATE quotes SET status = 'rejected' WHERE status = 'declined';
+ALTER TABLE quotes DROP CONSTRAINT IF EXISTS quotes_status_check;
+ALTER TABLE quotes ADD CONSTRAINT quotes_status_check CHECK (status IN ('proposed', 'accepted', 'rejected', 'voided', 'withdrawn', 'expired'));
+
+ALTER TABLE quotes DROP COLUMN IF EXISTS rebid_allowed;
+ALTER TABLE quotes DROP COLUMN IF EXISTS decline_reason;
@@ -0,0 +1,7 @@
+-- Clients can decline a single quote, optionally saying why, and later let
+-- that lawyer bid again
+ALTER TABLE quotes ADD COLUMN decline_reason TEXT;
+ALTER TABLE quotes ADD COLUMN rebid_allowed BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE quotes DROP CONSTRAINT IF EXISTS quotes_status_check;
+ALTER TABLE quotes ADD CONSTRAINT quotes_status_check CHECK (status IN ('proposed', 'accepted', 'rejected', 'voided', 'withdrawn', 'expired', 'declined'));
@@ -0,0 +1,4 @@
+ALTER TABLE quotes DROP COLUMN IF EXISTS agreed_days;
+ALTER TABLE quotes DROP COLUMN IF EXISTS agreed_amount;
+
+DROP TABLE IF EXISTS quote_offers;
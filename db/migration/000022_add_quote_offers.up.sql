@@ -0,0 +1,21 @@
+-- Counter-offers exchanged between the client and the lawyer on a quote
+CREATE TABLE quote_offers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    quote_id UUID NOT NULL REFERENCES quotes(id) ON DELETE CASCADE,
+    proposed_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    proposer_role VARCHAR(20) NOT NULL CHECK (proposer_role IN ('client', 'lawyer')),
+    amount NUMERIC(10, 2) NOT NULL,
+    expected_days INTEGER NOT NULL,
+    note TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'declined', 'countered', 'superseded')),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    responded_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_quote_offers_quote_id ON quote_offers(quote_id);
+-- Only one offer per quote waits for an answer at a time
+CREATE UNIQUE INDEX idx_quote_offers_pending ON quote_offers(quote_id) WHERE status = 'pending';
+
+-- Terms both sides agreed on, charged instead of the quoted ones
+ALTER TABLE quotes ADD COLUMN agreed_amount NUMERIC(10, 2);
+ALTER TABLE quotes ADD COLUMN agreed_days INTEGER;
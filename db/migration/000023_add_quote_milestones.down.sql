@@ -0,0 +1,3 @@
+ALTER TABLE payments DROP COLUMN IF EXISTS milestone_id;
+
+DROP TABLE IF EXISTS quote_milestones;
@@ -0,0 +1,18 @@
+-- Staged deliverables of a quote, each paid for separately
+CREATE TABLE quote_milestones (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    quote_id UUID NOT NULL REFERENCES quotes(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    amount NUMERIC(10, 2) NOT NULL,
+    due_date TIMESTAMP WITH TIME ZONE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'paid', 'delivered', 'approved')),
+    paid_at TIMESTAMP WITH TIME ZONE,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    approved_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    UNIQUE(quote_id, position)
+);
+
+-- The milestone a payment is for; NULL when it pays the whole quote
+ALTER TABLE payments ADD COLUMN milestone_id UUID REFERENCES quote_milestones(id) ON DELETE SET NULL;
@@ -0,0 +1,8 @@
+ALTER TABLE payments DROP COLUMN IF EXISTS kind;
+
+ALTER TABLE quotes DROP COLUMN IF EXISTS deposit_amount;
+ALTER TABLE quotes DROP COLUMN IF EXISTS cap_amount;
+ALTER TABLE quotes DROP COLUMN IF EXISTS estimated_hours_max;
+ALTER TABLE quotes DROP COLUMN IF EXISTS estimated_hours_min;
+ALTER TABLE quotes DROP COLUMN IF EXISTS hourly_rate;
+ALTER TABLE quotes DROP COLUMN IF EXISTS pricing_model;
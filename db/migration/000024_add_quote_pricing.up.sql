@@ -0,0 +1,12 @@
+-- How a quote is priced. For hourly and capped quotes amount holds the
+-- highest estimated total.
+ALTER TABLE quotes ADD COLUMN pricing_model VARCHAR(20) NOT NULL DEFAULT 'fixed' CHECK (pricing_model IN ('fixed', 'hourly', 'capped'));
+ALTER TABLE quotes ADD COLUMN hourly_rate NUMERIC(10, 2);
+ALTER TABLE quotes ADD COLUMN estimated_hours_min NUMERIC(8, 2);
+ALTER TABLE quotes ADD COLUMN estimated_hours_max NUMERIC(8, 2);
+ALTER TABLE quotes ADD COLUMN cap_amount NUMERIC(10, 2);
+ALTER TABLE quotes ADD COLUMN deposit_amount NUMERIC(10, 2);
+
+-- What a payment is for
+ALTER TABLE payments ADD COLUMN kind VARCHAR(20) NOT NULL DEFAULT 'full' CHECK (kind IN ('full', 'milestone', 'deposit', 'top_up'));
+UPDATE payments SET kind = 'milestone' WHERE milestone_id IS NOT NULL;
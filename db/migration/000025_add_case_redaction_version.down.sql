@@ -0,0 +1 @@
+ALTER TABLE cases DROP COLUMN IF EXISTS redaction_version;
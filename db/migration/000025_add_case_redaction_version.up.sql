@@ -0,0 +1,3 @@
+-- Records which detectors a stored redacted_description was made with, so
+-- descriptions redacted before a detector changed are redacted again.
+ALTER TABLE cases ADD COLUMN redaction_version INT NOT NULL DEFAULT 0;
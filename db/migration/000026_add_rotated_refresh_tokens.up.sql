@@ -0,0 +1,9 @@
+-- Refresh tokens a session has rotated away from. Presenting one again means
+-- the token was stolen or replayed, so the session is revoked.
+CREATE TABLE rotated_refresh_tokens (
+    token_hash VARCHAR(64) PRIMARY KEY,
+    session_id UUID NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+    rotated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_rotated_refresh_tokens_session_id ON rotated_refresh_tokens(session_id);
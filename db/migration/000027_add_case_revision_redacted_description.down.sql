@@ -0,0 +1 @@
+ALTER TABLE case_revisions DROP COLUMN IF EXISTS redacted_description;
@@ -0,0 +1,3 @@
+-- What lawyers saw of each revision's description, redacted when the edit was
+-- made. Revisions saved before this column existed are redacted on read.
+ALTER TABLE case_revisions ADD COLUMN redacted_description TEXT;
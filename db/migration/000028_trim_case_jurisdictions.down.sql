@@ -0,0 +1 @@
+-- Trimmed jurisdictions are not restored.
@@ -0,0 +1,5 @@
+-- Jurisdictions are trimmed on write and compared as lower(jurisdiction), so
+-- idx_cases_jurisdiction serves the marketplace filter. Trim the rows saved
+-- before that.
+UPDATE cases SET jurisdiction = NULLIF(trim(jurisdiction), '') WHERE jurisdiction IS DISTINCT FROM NULLIF(trim(jurisdiction), '');
+UPDATE saved_searches SET jurisdiction = NULLIF(trim(jurisdiction), '') WHERE jurisdiction IS DISTINCT FROM NULLIF(trim(jurisdiction), '');
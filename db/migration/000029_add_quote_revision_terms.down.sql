@@ -0,0 +1,7 @@
+ALTER TABLE quote_revisions DROP COLUMN IF EXISTS milestones;
+ALTER TABLE quote_revisions DROP COLUMN IF EXISTS deposit_amount;
+ALTER TABLE quote_revisions DROP COLUMN IF EXISTS cap_amount;
+ALTER TABLE quote_revisions DROP COLUMN IF EXISTS estimated_hours_max;
+ALTER TABLE quote_revisions DROP COLUMN IF EXISTS estimated_hours_min;
+ALTER TABLE quote_revisions DROP COLUMN IF EXISTS hourly_rate;
+ALTER TABLE quote_revisions DROP COLUMN IF EXISTS pricing_model;
@@ -0,0 +1,9 @@
+-- Pricing and milestones of a quote before each lawyer edit. Revisions saved
+-- before this have the fixed-price default and no milestone snapshot.
+ALTER TABLE quote_revisions ADD COLUMN pricing_model VARCHAR(20) NOT NULL DEFAULT 'fixed';
+ALTER TABLE quote_revisions ADD COLUMN hourly_rate NUMERIC(10, 2);
+ALTER TABLE quote_revisions ADD COLUMN estimated_hours_min NUMERIC(8, 2);
+ALTER TABLE quote_revisions ADD COLUMN estimated_hours_max NUMERIC(8, 2);
+ALTER TABLE quote_revisions ADD COLUMN cap_amount NUMERIC(10, 2);
+ALTER TABLE quote_revisions ADD COLUMN deposit_amount NUMERIC(10, 2);
+ALTER TABLE quote_revisions ADD COLUMN milestones JSONB;
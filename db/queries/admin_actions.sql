@@ -0,0 +1,16 @@
+-- name: CreateAdminAction :one
+INSERT INTO admin_actions (admin_id, action, target_type, target_id, reason)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListAdminActions :many
+SELECT a.*, u.name as admin_name, u.email as admin_email
+FROM admin_actions a
+JOIN users u ON a.admin_id = u.id
+WHERE ($1::VARCHAR IS NULL OR $1::VARCHAR = '' OR a.target_type = $1)
+ORDER BY a.created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: CountAdminActions :one
+SELECT COUNT(*) FROM admin_actions
+WHERE ($1::VARCHAR IS NULL OR $1::VARCHAR = '' OR target_type = $1);
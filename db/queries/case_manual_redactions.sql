@@ -0,0 +1,12 @@
+-- name: CreateCaseManualRedaction :one
+INSERT INTO case_manual_redactions (case_id, phrase)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: DeleteCaseManualRedactions :exec
+DELETE FROM case_manual_redactions WHERE case_id = $1;
+
+-- name: GetCaseManualRedactions :many
+SELECT * FROM case_manual_redactions
+WHERE case_id = $1
+ORDER BY created_at;
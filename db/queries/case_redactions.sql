@@ -0,0 +1,12 @@
+-- name: CreateCaseRedaction :one
+INSERT INTO case_redactions (case_id, detector, start_offset, end_offset)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: DeleteCaseRedactions :exec
+DELETE FROM case_redactions WHERE case_id = $1;
+
+-- name: GetCaseRedactions :many
+SELECT * FROM case_redactions
+WHERE case_id = $1
+ORDER BY start_offset;
@@ -0,0 +1,7 @@
+-- name: CreateCaseReview :one
+INSERT INTO case_reviews (case_id, lawyer_id, client_id, rating, comment)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetCaseReviewByCaseID :one
+SELECT * FROM case_reviews WHERE case_id = $1;
@@ -0,0 +1,12 @@
+-- name: CreateCaseRevision :one
+INSERT INTO case_revisions (case_id, revision, title, category, description, changed_fields, edited_by, redacted_description)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetLatestCaseRevisionNumber :one
+SELECT COALESCE(MAX(revision), 0)::INTEGER AS latest_revision FROM case_revisions WHERE case_id = $1;
+
+-- name: GetCaseRevisions :many
+SELECT * FROM case_revisions
+WHERE case_id = $1
+ORDER BY revision DESC;
@@ -0,0 +1,11 @@
+-- name: CreateCaseStatusHistory :one
+INSERT INTO case_status_history (case_id, from_status, to_status, actor_id, actor_role, reason)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetCaseStatusHistory :many
+SELECT h.*, u.name as actor_name
+FROM case_status_history h
+LEFT JOIN users u ON h.actor_id = u.id
+WHERE h.case_id = $1
+ORDER BY h.created_at ASC;
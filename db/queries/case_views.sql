@@ -0,0 +1,7 @@
+-- name: GetCaseView :one
+SELECT * FROM case_views WHERE case_id = $1 AND user_id = $2;
+
+-- name: RecordCaseView :exec
+INSERT INTO case_views (case_id, user_id)
+VALUES ($1, $2)
+ON CONFLICT (case_id, user_id) DO UPDATE SET viewed_at = NOW();
@@ -1,15 +1,19 @@
 -- name: CreateCase :one
-INSERT INTO cases (client_id, title, category, description, status)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO cases (client_id, title, category, description, status, redacted_description, jurisdiction, deadline, redaction_version, redacted_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
 RETURNING *;
 
 -- name: GetCaseByID :one
 SELECT * FROM cases WHERE id = $1;
 
+-- name: GetCaseByIDForUpdate :one
+SELECT * FROM cases WHERE id = $1 FOR UPDATE;
+
 -- name: GetCasesByClientID :many
 SELECT * FROM cases 
 WHERE client_id = $1
-ORDER BY created_at DESC
+  AND ($4::BOOLEAN = FALSE OR (created_at, id) < ($5::TIMESTAMPTZ, $6::UUID))
+ORDER BY created_at DESC, id DESC
 LIMIT $2 OFFSET $3;
 
 -- name: CountCasesByClientID :one
@@ -22,23 +26,94 @@ WHERE id = $1
 RETURNING *;
 
 -- name: ListOpenCases :many
-SELECT c.*, u.name as client_name
+SELECT c.*, u.name as client_name, u.email as client_email, qc.quote_count,
+       (CASE WHEN $5::TEXT = '' THEN 0
+             ELSE ts_rank(case_search_vector(c.title, c.redacted_description), websearch_to_tsquery('english', $5::TEXT))
+        END)::REAL as rank,
+       (CASE WHEN $5::TEXT = '' THEN ''
+             ELSE ts_headline('english', c.redacted_description, websearch_to_tsquery('english', $5::TEXT),
+                              'StartSel=«, StopSel=», MaxWords=35, MinWords=15, MaxFragments=2')
+        END)::TEXT as snippet,
+       sk.sort_key
 FROM cases c
 JOIN users u ON c.client_id = u.id
+CROSS JOIN LATERAL (
+    SELECT COUNT(*)::INT AS quote_count FROM quotes q WHERE q.case_id = c.id AND q.status = 'proposed'
+) qc
+CROSS JOIN LATERAL (
+    SELECT (CASE $11::TEXT
+                WHEN 'newest' THEN 0
+                WHEN 'fewest_quotes' THEN qc.quote_count
+                WHEN 'oldest_unanswered' THEN LEAST(qc.quote_count, 1)
+                WHEN 'deadline' THEN EXTRACT(EPOCH FROM COALESCE(c.deadline, TIMESTAMPTZ '9999-12-31'))
+                ELSE (CASE WHEN $5::TEXT = '' THEN 0
+                           ELSE ts_rank(case_search_vector(c.title, c.redacted_description), websearch_to_tsquery('english', $5::TEXT))
+                      END)::REAL
+            END)::FLOAT8 AS sort_key
+) sk
 WHERE c.status = 'open'
-  AND ($1::VARCHAR IS NULL OR $1 = '' OR c.category = $1)
+  AND ($1::VARCHAR IS NULL OR $1 = '' OR c.category = $1
+       OR c.category IN (SELECT ch.slug FROM categories ch JOIN categories p ON ch.parent_id = p.id WHERE p.slug = $1))
   AND ($2::TIMESTAMPTZ IS NULL OR c.created_at >= $2)
-ORDER BY c.created_at DESC
+  AND ($5::TEXT = '' OR (c.redacted_at IS NOT NULL AND c.redaction_version >= $16::INT
+                           AND case_search_vector(c.title, c.redacted_description) @@ websearch_to_tsquery('english', $5::TEXT)))
+  AND ($10::VARCHAR = '' OR c.jurisdiction IS NULL OR lower(c.jurisdiction) = lower(trim($10::VARCHAR)))
+  AND ($12::BOOLEAN = FALSE OR NOT EXISTS (SELECT 1 FROM quotes mq WHERE mq.case_id = c.id AND mq.lawyer_id = $13::UUID))
+  AND ($14::INT < 0 OR qc.quote_count >= $14::INT)
+  AND ($15::INT < 0 OR qc.quote_count <= $15::INT)
+  AND ($6::BOOLEAN = FALSE
+       OR ($11::TEXT IN ('', 'newest') AND (sk.sort_key, c.created_at, c.id) < ($7::FLOAT8, $8::TIMESTAMPTZ, $9::UUID))
+       OR ($11::TEXT NOT IN ('', 'newest') AND (sk.sort_key, c.created_at, c.id) > ($7::FLOAT8, $8::TIMESTAMPTZ, $9::UUID)))
+ORDER BY
+    CASE WHEN $11::TEXT IN ('', 'newest') THEN sk.sort_key END DESC,
+    CASE WHEN $11::TEXT IN ('', 'newest') THEN c.created_at END DESC,
+    CASE WHEN $11::TEXT IN ('', 'newest') THEN c.id END DESC,
+    sk.sort_key, c.created_at, c.id
 LIMIT $3 OFFSET $4;
 
 -- name: CountOpenCases :one
-SELECT COUNT(*) FROM cases
-WHERE status = 'open'
-  AND ($1::VARCHAR IS NULL OR $1 = '' OR category = $1)
-  AND ($2::TIMESTAMPTZ IS NULL OR created_at >= $2);
+SELECT COUNT(*) FROM cases c
+CROSS JOIN LATERAL (
+    SELECT COUNT(*)::INT AS quote_count FROM quotes q WHERE q.case_id = c.id AND q.status = 'proposed'
+) qc
+WHERE c.status = 'open'
+  AND ($1::VARCHAR IS NULL OR $1 = '' OR c.category = $1
+       OR c.category IN (SELECT ch.slug FROM categories ch JOIN categories p ON ch.parent_id = p.id WHERE p.slug = $1))
+  AND ($2::TIMESTAMPTZ IS NULL OR c.created_at >= $2)
+  AND ($3::TEXT = '' OR (c.redacted_at IS NOT NULL AND c.redaction_version >= $9::INT
+                           AND case_search_vector(c.title, c.redacted_description) @@ websearch_to_tsquery('english', $3::TEXT)))
+  AND ($4::VARCHAR = '' OR c.jurisdiction IS NULL OR lower(c.jurisdiction) = lower(trim($4::VARCHAR)))
+  AND ($5::BOOLEAN = FALSE OR NOT EXISTS (SELECT 1 FROM quotes mq WHERE mq.case_id = c.id AND mq.lawyer_id = $6::UUID))
+  AND ($7::INT < 0 OR qc.quote_count >= $7::INT)
+  AND ($8::INT < 0 OR qc.quote_count <= $8::INT);
 
 -- name: GetCaseWithClient :one
 SELECT c.*, u.name as client_name, u.email as client_email
 FROM cases c
 JOIN users u ON c.client_id = u.id
 WHERE c.id = $1;
+
+-- name: TransitionCaseStatus :one
+UPDATE cases
+SET status = sqlc.arg(to_status), updated_at = NOW()
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(from_status)
+RETURNING *;
+
+-- name: UpdateCaseDetails :one
+UPDATE cases
+SET title = $2, category = $3, description = $4, redacted_description = $5, redaction_version = $6, redacted_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND status = 'open'
+RETURNING *;
+
+-- name: UpdateCaseRedactedDescription :one
+UPDATE cases
+SET redacted_description = $2, redaction_version = $3, redacted_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListCasesWithStaleRedaction :many
+SELECT * FROM cases
+WHERE redacted_at IS NULL OR redaction_version < $1
+ORDER BY created_at
+LIMIT $2
+FOR UPDATE SKIP LOCKED;
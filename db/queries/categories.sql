@@ -0,0 +1,9 @@
+-- name: ListActiveCategories :many
+SELECT c.*, p.slug as parent_slug
+FROM categories c
+LEFT JOIN categories p ON c.parent_id = p.id
+WHERE c.is_active = TRUE
+ORDER BY c.name ASC;
+
+-- name: GetCategoryBySlug :one
+SELECT * FROM categories WHERE slug = $1;
@@ -0,0 +1,53 @@
+-- name: CreateLawyerVerification :one
+INSERT INTO lawyer_verifications (lawyer_id, jurisdiction, bar_number)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetLawyerVerificationByID :one
+SELECT v.*, u.name as lawyer_name, u.email as lawyer_email
+FROM lawyer_verifications v
+JOIN users u ON v.lawyer_id = u.id
+WHERE v.id = $1;
+
+-- name: GetLatestLawyerVerificationByLawyerID :one
+SELECT * FROM lawyer_verifications
+WHERE lawyer_id = $1
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: ListLawyerVerifications :many
+SELECT v.*, u.name as lawyer_name, u.email as lawyer_email
+FROM lawyer_verifications v
+JOIN users u ON v.lawyer_id = u.id
+WHERE ($1::VARCHAR IS NULL OR $1 = '' OR v.status = $1)
+ORDER BY v.created_at ASC
+LIMIT $2 OFFSET $3;
+
+-- name: CountLawyerVerifications :one
+SELECT COUNT(*) FROM lawyer_verifications
+WHERE ($1::VARCHAR IS NULL OR $1 = '' OR status = $1);
+
+-- name: ReviewLawyerVerification :one
+UPDATE lawyer_verifications
+SET status = $2, reviewed_by = $3, review_note = $4, reviewed_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: CreateLawyerVerificationDocument :one
+INSERT INTO lawyer_verification_documents (verification_id, file_name, file_path, file_size, mime_type)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetLawyerVerificationDocuments :many
+SELECT * FROM lawyer_verification_documents
+WHERE verification_id = $1
+ORDER BY created_at ASC;
+
+-- name: CountLawyerVerificationDocuments :one
+SELECT COUNT(*) FROM lawyer_verification_documents WHERE verification_id = $1;
+
+-- name: UpdatePendingLawyerVerificationCredentials :one
+UPDATE lawyer_verifications
+SET jurisdiction = $2, bar_number = $3, updated_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
@@ -0,0 +1,27 @@
+-- name: CreateNotification :one
+INSERT INTO notifications (user_id, type, data)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: ListNotificationsByUserID :many
+SELECT * FROM notifications
+WHERE user_id = $1
+  AND ($2::BOOLEAN = FALSE OR read_at IS NULL)
+ORDER BY created_at DESC
+LIMIT $3 OFFSET $4;
+
+-- name: CountNotificationsByUserID :one
+SELECT COUNT(*) FROM notifications
+WHERE user_id = $1
+  AND ($2::BOOLEAN = FALSE OR read_at IS NULL);
+
+-- name: MarkNotificationRead :one
+UPDATE notifications
+SET read_at = COALESCE(read_at, NOW())
+WHERE id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: MarkAllNotificationsRead :exec
+UPDATE notifications
+SET read_at = NOW()
+WHERE user_id = $1 AND read_at IS NULL;
@@ -1,6 +1,6 @@
 -- name: CreatePayment :one
-INSERT INTO payments (quote_id, stripe_payment_intent_id, amount, status)
-VALUES ($1, $2, $3, $4)
+INSERT INTO payments (quote_id, stripe_payment_intent_id, amount, status, milestone_id, kind)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
 
 -- name: GetPaymentByID :one
@@ -17,3 +17,23 @@ RETURNING *;
 
 -- name: GetPaymentByQuoteID :one
 SELECT * FROM payments WHERE quote_id = $1 LIMIT 1;
+
+-- name: CancelPendingPaymentsByQuoteID :many
+UPDATE payments
+SET status = 'canceled', updated_at = NOW()
+WHERE quote_id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: CancelPendingPaymentsByCaseID :many
+UPDATE payments
+SET status = 'canceled', updated_at = NOW()
+WHERE status = 'pending'
+  AND quote_id IN (SELECT id FROM quotes WHERE case_id = $1)
+RETURNING *;
+
+-- name: CountPendingPaymentsByQuoteID :one
+SELECT COUNT(*) FROM payments WHERE quote_id = $1 AND status = 'pending';
+
+-- name: SumActivePaymentsByQuoteID :one
+SELECT COALESCE(SUM(amount), 0)::NUMERIC AS total FROM payments
+WHERE quote_id = $1 AND status IN ('pending', 'succeeded');
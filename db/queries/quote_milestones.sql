@@ -0,0 +1,39 @@
+-- name: CreateQuoteMilestone :one
+INSERT INTO quote_milestones (quote_id, position, title, amount, due_date)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: DeleteQuoteMilestones :exec
+DELETE FROM quote_milestones WHERE quote_id = $1;
+
+-- name: GetQuoteMilestoneByID :one
+SELECT * FROM quote_milestones WHERE id = $1;
+
+-- name: GetQuoteMilestones :many
+SELECT * FROM quote_milestones
+WHERE quote_id = $1
+ORDER BY position ASC;
+
+-- name: GetQuoteMilestonesByCaseID :many
+SELECT qm.* FROM quote_milestones qm
+JOIN quotes q ON qm.quote_id = q.id
+WHERE q.case_id = $1
+ORDER BY qm.quote_id, qm.position ASC;
+
+-- name: MarkQuoteMilestonePaid :one
+UPDATE quote_milestones
+SET status = 'paid', paid_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: MarkQuoteMilestoneDelivered :one
+UPDATE quote_milestones
+SET status = 'delivered', delivered_at = NOW()
+WHERE id = $1 AND status = 'paid'
+RETURNING *;
+
+-- name: ApproveQuoteMilestone :one
+UPDATE quote_milestones
+SET status = 'approved', approved_at = NOW()
+WHERE id = $1 AND status = 'delivered'
+RETURNING *;
@@ -0,0 +1,26 @@
+-- name: CreateQuoteOffer :one
+INSERT INTO quote_offers (quote_id, proposed_by, proposer_role, amount, expected_days, note)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetQuoteOfferByID :one
+SELECT * FROM quote_offers WHERE id = $1;
+
+-- name: GetPendingQuoteOffer :one
+SELECT * FROM quote_offers WHERE quote_id = $1 AND status = 'pending';
+
+-- name: GetQuoteOffersByQuoteID :many
+SELECT * FROM quote_offers
+WHERE quote_id = $1
+ORDER BY created_at ASC;
+
+-- name: CloseQuoteOffer :one
+UPDATE quote_offers
+SET status = $2, responded_at = NOW()
+WHERE id = $1 AND status = 'pending'
+RETURNING *;
+
+-- name: SupersedePendingQuoteOffers :exec
+UPDATE quote_offers
+SET status = 'superseded', responded_at = NOW()
+WHERE quote_id = $1 AND status = 'pending';
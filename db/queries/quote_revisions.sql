@@ -0,0 +1,14 @@
+-- name: CreateQuoteRevision :one
+INSERT INTO quote_revisions (quote_id, revision, amount, expected_days, note, pricing_model, hourly_rate,
+                             estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount, milestones)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+RETURNING *;
+
+-- name: GetLatestQuoteRevisionNumber :one
+SELECT COALESCE(MAX(revision), 0)::INTEGER AS latest_revision FROM quote_revisions WHERE quote_id = $1;
+
+-- name: GetQuoteRevisionsByCaseID :many
+SELECT qr.* FROM quote_revisions qr
+JOIN quotes q ON qr.quote_id = q.id
+WHERE q.case_id = $1
+ORDER BY qr.quote_id, qr.revision DESC;
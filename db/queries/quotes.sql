@@ -1,17 +1,26 @@
 -- name: CreateQuote :one
-INSERT INTO quotes (case_id, lawyer_id, amount, expected_days, note, status)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO quotes (case_id, lawyer_id, amount, expected_days, note, status, valid_until,
+                    pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 RETURNING *;
 
 -- name: UpdateQuote :one
 UPDATE quotes
-SET amount = $2, expected_days = $3, note = $4, status = 'proposed', updated_at = NOW()
-WHERE id = $1 AND status != 'accepted'
+SET amount = $2, expected_days = $3, note = $4, valid_until = $5, status = 'proposed',
+    pricing_model = $6, hourly_rate = $7, estimated_hours_min = $8, estimated_hours_max = $9,
+    cap_amount = $10, deposit_amount = $11,
+    decline_reason = NULL, rebid_allowed = FALSE, agreed_amount = NULL, agreed_days = NULL,
+    updated_at = NOW()
+WHERE id = $1 AND status NOT IN ('accepted', 'voided', 'withdrawn')
+  AND (status != 'declined' OR rebid_allowed)
 RETURNING *;
 
 -- name: GetQuoteByID :one
 SELECT * FROM quotes WHERE id = $1;
 
+-- name: GetQuoteByIDForUpdate :one
+SELECT * FROM quotes WHERE id = $1 FOR UPDATE;
+
 -- name: GetQuoteByCaseAndLawyer :one
 SELECT * FROM quotes 
 WHERE case_id = $1 AND lawyer_id = $2;
@@ -32,7 +41,8 @@ FROM quotes q
 JOIN cases c ON q.case_id = c.id
 WHERE q.lawyer_id = $1
   AND ($2::VARCHAR IS NULL OR $2::VARCHAR = '' OR q.status = $2)
-ORDER BY q.created_at DESC
+  AND ($5::BOOLEAN = FALSE OR (q.created_at, q.id) < ($6::TIMESTAMPTZ, $7::UUID))
+ORDER BY q.created_at DESC, q.id DESC
 LIMIT $3 OFFSET $4;
 
 -- name: CountQuotesByLawyerID :one
@@ -56,3 +66,66 @@ RETURNING *;
 SELECT * FROM quotes 
 WHERE case_id = $1 AND status = 'accepted'
 LIMIT 1;
+
+-- name: RejectProposedQuotesByCaseID :many
+UPDATE quotes
+SET status = 'rejected', updated_at = NOW()
+WHERE case_id = $1 AND status = 'proposed'
+RETURNING *;
+
+-- name: VoidQuote :one
+UPDATE quotes
+SET status = 'voided', updated_at = NOW()
+WHERE id = $1 AND status = 'proposed'
+RETURNING *;
+
+-- name: WithdrawQuote :one
+UPDATE quotes
+SET status = 'withdrawn', updated_at = NOW()
+WHERE id = $1 AND status = 'proposed'
+  AND NOT EXISTS (SELECT 1 FROM payments WHERE quote_id = $1 AND status = 'pending')
+RETURNING *;
+
+-- name: ExpireStaleQuotes :many
+UPDATE quotes
+SET status = 'expired', updated_at = NOW()
+WHERE id IN (
+    SELECT sq.id FROM quotes sq
+    WHERE sq.status = 'proposed'
+      AND COALESCE(sq.valid_until, sq.created_at + make_interval(days => $1::INTEGER)) < NOW()
+      AND NOT EXISTS (SELECT 1 FROM payments WHERE quote_id = sq.id AND status = 'pending')
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: CompareQuotesByCaseID :many
+SELECT q.*, u.name as lawyer_name,
+       (SELECT COUNT(*) FROM quotes aq
+        JOIN cases ac ON aq.case_id = ac.id
+        WHERE aq.lawyer_id = q.lawyer_id AND aq.status = 'accepted' AND ac.status = 'closed') as completed_cases,
+       (SELECT COUNT(*) FROM case_reviews r WHERE r.lawyer_id = q.lawyer_id) as review_count,
+       (SELECT COALESCE(AVG(r.rating), 0)::FLOAT8 FROM case_reviews r WHERE r.lawyer_id = q.lawyer_id) as average_rating,
+       (SELECT COUNT(*) FROM quote_revisions qr WHERE qr.quote_id = q.id) as revision_count
+FROM quotes q
+JOIN users u ON q.lawyer_id = u.id
+WHERE q.case_id = $1
+ORDER BY q.created_at ASC;
+
+-- name: DeclineQuote :one
+UPDATE quotes
+SET status = 'declined', decline_reason = $2, rebid_allowed = FALSE, updated_at = NOW()
+WHERE id = $1 AND status = 'proposed'
+  AND NOT EXISTS (SELECT 1 FROM payments WHERE quote_id = $1 AND status = 'pending')
+RETURNING *;
+
+-- name: AllowQuoteRebid :one
+UPDATE quotes
+SET rebid_allowed = TRUE, updated_at = NOW()
+WHERE id = $1 AND status = 'declined'
+RETURNING *;
+
+-- name: SetQuoteAgreedTerms :one
+UPDATE quotes
+SET agreed_amount = $2, agreed_days = $3, updated_at = NOW()
+WHERE id = $1 AND status = 'proposed'
+RETURNING *;
@@ -0,0 +1,30 @@
+-- name: CreateSavedSearch :one
+INSERT INTO saved_searches (lawyer_id, name, category, query, jurisdiction)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListSavedSearchesByLawyerID :many
+SELECT * FROM saved_searches
+WHERE lawyer_id = $1
+ORDER BY created_at DESC;
+
+-- name: CountSavedSearchesByLawyerID :one
+SELECT COUNT(*) FROM saved_searches WHERE lawyer_id = $1;
+
+-- name: DeleteSavedSearch :one
+DELETE FROM saved_searches
+WHERE id = $1 AND lawyer_id = $2
+RETURNING *;
+
+-- name: ListSavedSearchesMatchingCase :many
+SELECT DISTINCT ON (s.lawyer_id) s.*
+FROM saved_searches s
+JOIN users u ON s.lawyer_id = u.id
+JOIN cases c ON c.id = $1
+WHERE c.status = 'open'
+  AND u.suspended_at IS NULL
+  AND (s.category IS NULL OR c.category = s.category
+       OR c.category IN (SELECT ch.slug FROM categories ch JOIN categories p ON ch.parent_id = p.id WHERE p.slug = s.category))
+  AND (s.query IS NULL OR case_search_vector(c.title, c.redacted_description) @@ websearch_to_tsquery('english', s.query))
+  AND (s.jurisdiction IS NULL OR c.jurisdiction IS NULL OR lower(c.jurisdiction) = lower(s.jurisdiction))
+ORDER BY s.lawyer_id, s.created_at;
@@ -0,0 +1,39 @@
+-- name: CreateSession :one
+INSERT INTO sessions (user_id, refresh_token_hash, user_agent, ip_address, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetSessionByID :one
+SELECT * FROM sessions WHERE id = $1;
+
+-- name: GetSessionByRefreshTokenHash :one
+SELECT * FROM sessions WHERE refresh_token_hash = $1;
+
+-- name: RotateSessionRefreshToken :one
+UPDATE sessions
+SET refresh_token_hash = sqlc.arg(new_refresh_token_hash), expires_at = sqlc.arg(expires_at), updated_at = NOW()
+WHERE id = sqlc.arg(id) AND refresh_token_hash = sqlc.arg(refresh_token_hash) AND revoked_at IS NULL
+RETURNING *;
+
+-- name: RevokeSession :exec
+UPDATE sessions
+SET revoked_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND revoked_at IS NULL;
+
+-- name: RevokeUserSessions :exec
+UPDATE sessions
+SET revoked_at = NOW(), updated_at = NOW()
+WHERE user_id = $1 AND revoked_at IS NULL;
+
+-- name: RevokeOtherUserSessions :exec
+UPDATE sessions
+SET revoked_at = NOW(), updated_at = NOW()
+WHERE user_id = $1 AND id != $2 AND revoked_at IS NULL;
+
+-- name: CreateRotatedRefreshToken :exec
+INSERT INTO rotated_refresh_tokens (token_hash, session_id)
+VALUES ($1, $2)
+ON CONFLICT (token_hash) DO NOTHING;
+
+-- name: GetSessionIDByRotatedRefreshTokenHash :one
+SELECT session_id FROM rotated_refresh_tokens WHERE token_hash = $1;
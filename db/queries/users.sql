@@ -17,3 +17,38 @@ SET name = COALESCE($2, name),
     updated_at = NOW()
 WHERE id = $1
 RETURNING *;
+
+-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = $2, updated_at = NOW()
+WHERE id = $1;
+
+-- name: MarkUserEmailVerified :one
+UPDATE users
+SET email_verified_at = COALESCE(email_verified_at, NOW()), updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: ListUsers :many
+SELECT * FROM users
+WHERE ($1::VARCHAR IS NULL OR $1::VARCHAR = '' OR role = $1)
+  AND ($2::VARCHAR IS NULL OR $2::VARCHAR = '' OR email ILIKE '%' || $2 || '%' OR name ILIKE '%' || $2 || '%')
+ORDER BY created_at DESC
+LIMIT $3 OFFSET $4;
+
+-- name: CountUsers :one
+SELECT COUNT(*) FROM users
+WHERE ($1::VARCHAR IS NULL OR $1::VARCHAR = '' OR role = $1)
+  AND ($2::VARCHAR IS NULL OR $2::VARCHAR = '' OR email ILIKE '%' || $2 || '%' OR name ILIKE '%' || $2 || '%');
+
+-- name: SuspendUser :one
+UPDATE users
+SET suspended_at = NOW(), suspension_reason = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: ReactivateUser :one
+UPDATE users
+SET suspended_at = NULL, suspension_reason = NULL, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
@@ -25,36 +25,67 @@ func (q *Queries) CountCasesByClientID(ctx context.Context, clientID uuid.UUID)
 }
 
 const CountOpenCases = `-- name: CountOpenCases :one
-SELECT COUNT(*) FROM cases
-WHERE status = 'open'
-  AND ($1::VARCHAR IS NULL OR $1 = '' OR category = $1)
-  AND ($2::TIMESTAMPTZ IS NULL OR created_at >= $2)
+SELECT COUNT(*) FROM cases c
+CROSS JOIN LATERAL (
+    SELECT COUNT(*)::INT AS quote_count FROM quotes q WHERE q.case_id = c.id AND q.status = 'proposed'
+) qc
+WHERE c.status = 'open'
+  AND ($1::VARCHAR IS NULL OR $1 = '' OR c.category = $1
+       OR c.category IN (SELECT ch.slug FROM categories ch JOIN categories p ON ch.parent_id = p.id WHERE p.slug = $1))
+  AND ($2::TIMESTAMPTZ IS NULL OR c.created_at >= $2)
+  AND ($3::TEXT = '' OR (c.redacted_at IS NOT NULL AND c.redaction_version >= $9::INT
+                           AND case_search_vector(c.title, c.redacted_description) @@ websearch_to_tsquery('english', $3::TEXT)))
+  AND ($4::VARCHAR = '' OR c.jurisdiction IS NULL OR lower(c.jurisdiction) = lower(trim($4::VARCHAR)))
+  AND ($5::BOOLEAN = FALSE OR NOT EXISTS (SELECT 1 FROM quotes mq WHERE mq.case_id = c.id AND mq.lawyer_id = $6::UUID))
+  AND ($7::INT < 0 OR qc.quote_count >= $7::INT)
+  AND ($8::INT < 0 OR qc.quote_count <= $8::INT)
 `
 
 type CountOpenCasesParams struct {
 	Column1 string    `json:"column_1"`
 	Column2 time.Time `json:"column_2"`
+	Column3 string    `json:"column_3"`
+	Column4 string    `json:"column_4"`
+	Column5 bool      `json:"column_5"`
+	Column6 uuid.UUID `json:"column_6"`
+	Column7 int32     `json:"column_7"`
+	Column8 int32     `json:"column_8"`
+	Column9 int32     `json:"column_9"`
 }
 
 func (q *Queries) CountOpenCases(ctx context.Context, arg *CountOpenCasesParams) (int64, error) {
-	row := q.db.QueryRow(ctx, CountOpenCases, arg.Column1, arg.Column2)
+	row := q.db.QueryRow(ctx, CountOpenCases,
+		arg.Column1,
+		arg.Column2,
+		arg.Column3,
+		arg.Column4,
+		arg.Column5,
+		arg.Column6,
+		arg.Column7,
+		arg.Column8,
+		arg.Column9,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const CreateCase = `-- name: CreateCase :one
-INSERT INTO cases (client_id, title, category, description, status)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id, client_id, title, category, description, status, created_at, updated_at
+INSERT INTO cases (client_id, title, category, description, status, redacted_description, jurisdiction, deadline, redaction_version, redacted_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NOW())
+RETURNING id, client_id, title, category, description, status, created_at, updated_at, redacted_description, jurisdiction, deadline, redacted_at, redaction_version
 `
 
 type CreateCaseParams struct {
-	ClientID    uuid.UUID `json:"client_id"`
-	Title       string    `json:"title"`
-	Category    string    `json:"category"`
-	Description string    `json:"description"`
-	Status      string    `json:"status"`
+	ClientID            uuid.UUID          `json:"client_id"`
+	Title               string             `json:"title"`
+	Category            string             `json:"category"`
+	Description         string             `json:"description"`
+	Status              string             `json:"status"`
+	RedactedDescription string             `json:"redacted_description"`
+	Jurisdiction        pgtype.Text        `json:"jurisdiction"`
+	Deadline            pgtype.Timestamptz `json:"deadline"`
+	RedactionVersion    int32              `json:"redaction_version"`
 }
 
 func (q *Queries) CreateCase(ctx context.Context, arg *CreateCaseParams) (*Case, error) {
@@ -64,6 +95,10 @@ func (q *Queries) CreateCase(ctx context.Context, arg *CreateCaseParams) (*Case,
 		arg.Category,
 		arg.Description,
 		arg.Status,
+		arg.RedactedDescription,
+		arg.Jurisdiction,
+		arg.Deadline,
+		arg.RedactionVersion,
 	)
 	var i Case
 	err := row.Scan(
@@ -75,12 +110,17 @@ func (q *Queries) CreateCase(ctx context.Context, arg *CreateCaseParams) (*Case,
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RedactedDescription,
+		&i.Jurisdiction,
+		&i.Deadline,
+		&i.RedactedAt,
+		&i.RedactionVersion,
 	)
 	return &i, err
 }
 
 const GetCaseByID = `-- name: GetCaseByID :one
-SELECT id, client_id, title, category, description, status, created_at, updated_at FROM cases WHERE id = $1
+SELECT id, client_id, title, category, description, status, created_at, updated_at, redacted_description, jurisdiction, deadline, redacted_at, redaction_version FROM cases WHERE id = $1
 `
 
 func (q *Queries) GetCaseByID(ctx context.Context, id uuid.UUID) (*Case, error) {
@@ -95,28 +135,63 @@ func (q *Queries) GetCaseByID(ctx context.Context, id uuid.UUID) (*Case, error)
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RedactedDescription,
+		&i.Jurisdiction,
+		&i.Deadline,
+		&i.RedactedAt,
+		&i.RedactionVersion,
+	)
+	return &i, err
+}
+
+const GetCaseByIDForUpdate = `-- name: GetCaseByIDForUpdate :one
+SELECT id, client_id, title, category, description, status, created_at, updated_at, redacted_description, jurisdiction, deadline, redacted_at, redaction_version FROM cases WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetCaseByIDForUpdate(ctx context.Context, id uuid.UUID) (*Case, error) {
+	row := q.db.QueryRow(ctx, GetCaseByIDForUpdate, id)
+	var i Case
+	err := row.Scan(
+		&i.ID,
+		&i.ClientID,
+		&i.Title,
+		&i.Category,
+		&i.Description,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RedactedDescription,
+		&i.Jurisdiction,
+		&i.Deadline,
+		&i.RedactedAt,
+		&i.RedactionVersion,
 	)
 	return &i, err
 }
 
 const GetCaseWithClient = `-- name: GetCaseWithClient :one
-SELECT c.id, c.client_id, c.title, c.category, c.description, c.status, c.created_at, c.updated_at, u.name as client_name, u.email as client_email
+SELECT c.id, c.client_id, c.title, c.category, c.description, c.status, c.created_at, c.updated_at, c.redacted_description, c.jurisdiction, c.deadline, c.redacted_at, c.redaction_version, u.name as client_name, u.email as client_email
 FROM cases c
 JOIN users u ON c.client_id = u.id
 WHERE c.id = $1
 `
 
 type GetCaseWithClientRow struct {
-	ID          uuid.UUID          `json:"id"`
-	ClientID    uuid.UUID          `json:"client_id"`
-	Title       string             `json:"title"`
-	Category    string             `json:"category"`
-	Description string             `json:"description"`
-	Status      string             `json:"status"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
-	ClientName  pgtype.Text        `json:"client_name"`
-	ClientEmail string             `json:"client_email"`
+	ID                  uuid.UUID          `json:"id"`
+	ClientID            uuid.UUID          `json:"client_id"`
+	Title               string             `json:"title"`
+	Category            string             `json:"category"`
+	Description         string             `json:"description"`
+	Status              string             `json:"status"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	RedactedDescription string             `json:"redacted_description"`
+	Jurisdiction        pgtype.Text        `json:"jurisdiction"`
+	Deadline            pgtype.Timestamptz `json:"deadline"`
+	RedactedAt          pgtype.Timestamptz `json:"redacted_at"`
+	RedactionVersion    int32              `json:"redaction_version"`
+	ClientName          pgtype.Text        `json:"client_name"`
+	ClientEmail         string             `json:"client_email"`
 }
 
 func (q *Queries) GetCaseWithClient(ctx context.Context, id uuid.UUID) (*GetCaseWithClientRow, error) {
@@ -131,6 +206,11 @@ func (q *Queries) GetCaseWithClient(ctx context.Context, id uuid.UUID) (*GetCase
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RedactedDescription,
+		&i.Jurisdiction,
+		&i.Deadline,
+		&i.RedactedAt,
+		&i.RedactionVersion,
 		&i.ClientName,
 		&i.ClientEmail,
 	)
@@ -138,9 +218,10 @@ func (q *Queries) GetCaseWithClient(ctx context.Context, id uuid.UUID) (*GetCase
 }
 
 const GetCasesByClientID = `-- name: GetCasesByClientID :many
-SELECT id, client_id, title, category, description, status, created_at, updated_at FROM cases 
+SELECT id, client_id, title, category, description, status, created_at, updated_at, redacted_description, jurisdiction, deadline, redacted_at, redaction_version FROM cases 
 WHERE client_id = $1
-ORDER BY created_at DESC
+  AND ($4::BOOLEAN = FALSE OR (created_at, id) < ($5::TIMESTAMPTZ, $6::UUID))
+ORDER BY created_at DESC, id DESC
 LIMIT $2 OFFSET $3
 `
 
@@ -148,10 +229,20 @@ type GetCasesByClientIDParams struct {
 	ClientID uuid.UUID `json:"client_id"`
 	Limit    int32     `json:"limit"`
 	Offset   int32     `json:"offset"`
+	Column4  bool      `json:"column_4"`
+	Column5  time.Time `json:"column_5"`
+	Column6  uuid.UUID `json:"column_6"`
 }
 
 func (q *Queries) GetCasesByClientID(ctx context.Context, arg *GetCasesByClientIDParams) ([]*Case, error) {
-	rows, err := q.db.Query(ctx, GetCasesByClientID, arg.ClientID, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, GetCasesByClientID,
+		arg.ClientID,
+		arg.Limit,
+		arg.Offset,
+		arg.Column4,
+		arg.Column5,
+		arg.Column6,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -168,6 +259,58 @@ func (q *Queries) GetCasesByClientID(ctx context.Context, arg *GetCasesByClientI
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RedactedDescription,
+			&i.Jurisdiction,
+			&i.Deadline,
+			&i.RedactedAt,
+			&i.RedactionVersion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListCasesWithStaleRedaction = `-- name: ListCasesWithStaleRedaction :many
+SELECT id, client_id, title, category, description, status, created_at, updated_at, redacted_description, jurisdiction, deadline, redacted_at, redaction_version FROM cases
+WHERE redacted_at IS NULL OR redaction_version < $1
+ORDER BY created_at
+LIMIT $2
+FOR UPDATE SKIP LOCKED
+`
+
+type ListCasesWithStaleRedactionParams struct {
+	RedactionVersion int32 `json:"redaction_version"`
+	Limit            int32 `json:"limit"`
+}
+
+func (q *Queries) ListCasesWithStaleRedaction(ctx context.Context, arg *ListCasesWithStaleRedactionParams) ([]*Case, error) {
+	rows, err := q.db.Query(ctx, ListCasesWithStaleRedaction, arg.RedactionVersion, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Case{}
+	for rows.Next() {
+		var i Case
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClientID,
+			&i.Title,
+			&i.Category,
+			&i.Description,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RedactedDescription,
+			&i.Jurisdiction,
+			&i.Deadline,
+			&i.RedactedAt,
+			&i.RedactionVersion,
 		); err != nil {
 			return nil, err
 		}
@@ -180,33 +323,91 @@ func (q *Queries) GetCasesByClientID(ctx context.Context, arg *GetCasesByClientI
 }
 
 const ListOpenCases = `-- name: ListOpenCases :many
-SELECT c.id, c.client_id, c.title, c.category, c.description, c.status, c.created_at, c.updated_at, u.name as client_name
+SELECT c.id, c.client_id, c.title, c.category, c.description, c.status, c.created_at, c.updated_at, c.redacted_description, c.jurisdiction, c.deadline, c.redacted_at, c.redaction_version, u.name as client_name, u.email as client_email, qc.quote_count,
+       (CASE WHEN $5::TEXT = '' THEN 0
+             ELSE ts_rank(case_search_vector(c.title, c.redacted_description), websearch_to_tsquery('english', $5::TEXT))
+        END)::REAL as rank,
+       (CASE WHEN $5::TEXT = '' THEN ''
+             ELSE ts_headline('english', c.redacted_description, websearch_to_tsquery('english', $5::TEXT),
+                              'StartSel=«, StopSel=», MaxWords=35, MinWords=15, MaxFragments=2')
+        END)::TEXT as snippet,
+       sk.sort_key
 FROM cases c
 JOIN users u ON c.client_id = u.id
+CROSS JOIN LATERAL (
+    SELECT COUNT(*)::INT AS quote_count FROM quotes q WHERE q.case_id = c.id AND q.status = 'proposed'
+) qc
+CROSS JOIN LATERAL (
+    SELECT (CASE $11::TEXT
+                WHEN 'newest' THEN 0
+                WHEN 'fewest_quotes' THEN qc.quote_count
+                WHEN 'oldest_unanswered' THEN LEAST(qc.quote_count, 1)
+                WHEN 'deadline' THEN EXTRACT(EPOCH FROM COALESCE(c.deadline, TIMESTAMPTZ '9999-12-31'))
+                ELSE (CASE WHEN $5::TEXT = '' THEN 0
+                           ELSE ts_rank(case_search_vector(c.title, c.redacted_description), websearch_to_tsquery('english', $5::TEXT))
+                      END)::REAL
+            END)::FLOAT8 AS sort_key
+) sk
 WHERE c.status = 'open'
-  AND ($1::VARCHAR IS NULL OR $1 = '' OR c.category = $1)
+  AND ($1::VARCHAR IS NULL OR $1 = '' OR c.category = $1
+       OR c.category IN (SELECT ch.slug FROM categories ch JOIN categories p ON ch.parent_id = p.id WHERE p.slug = $1))
   AND ($2::TIMESTAMPTZ IS NULL OR c.created_at >= $2)
-ORDER BY c.created_at DESC
+  AND ($5::TEXT = '' OR (c.redacted_at IS NOT NULL AND c.redaction_version >= $16::INT
+                           AND case_search_vector(c.title, c.redacted_description) @@ websearch_to_tsquery('english', $5::TEXT)))
+  AND ($10::VARCHAR = '' OR c.jurisdiction IS NULL OR lower(c.jurisdiction) = lower(trim($10::VARCHAR)))
+  AND ($12::BOOLEAN = FALSE OR NOT EXISTS (SELECT 1 FROM quotes mq WHERE mq.case_id = c.id AND mq.lawyer_id = $13::UUID))
+  AND ($14::INT < 0 OR qc.quote_count >= $14::INT)
+  AND ($15::INT < 0 OR qc.quote_count <= $15::INT)
+  AND ($6::BOOLEAN = FALSE
+       OR ($11::TEXT IN ('', 'newest') AND (sk.sort_key, c.created_at, c.id) < ($7::FLOAT8, $8::TIMESTAMPTZ, $9::UUID))
+       OR ($11::TEXT NOT IN ('', 'newest') AND (sk.sort_key, c.created_at, c.id) > ($7::FLOAT8, $8::TIMESTAMPTZ, $9::UUID)))
+ORDER BY
+    CASE WHEN $11::TEXT IN ('', 'newest') THEN sk.sort_key END DESC,
+    CASE WHEN $11::TEXT IN ('', 'newest') THEN c.created_at END DESC,
+    CASE WHEN $11::TEXT IN ('', 'newest') THEN c.id END DESC,
+    sk.sort_key, c.created_at, c.id
 LIMIT $3 OFFSET $4
 `
 
 type ListOpenCasesParams struct {
-	Column1 string    `json:"column_1"`
-	Column2 time.Time `json:"column_2"`
-	Limit   int32     `json:"limit"`
-	Offset  int32     `json:"offset"`
+	Column1  string    `json:"column_1"`
+	Column2  time.Time `json:"column_2"`
+	Limit    int32     `json:"limit"`
+	Offset   int32     `json:"offset"`
+	Column5  string    `json:"column_5"`
+	Column6  bool      `json:"column_6"`
+	Column7  float64   `json:"column_7"`
+	Column8  time.Time `json:"column_8"`
+	Column9  uuid.UUID `json:"column_9"`
+	Column10 string    `json:"column_10"`
+	Column11 string    `json:"column_11"`
+	Column12 bool      `json:"column_12"`
+	Column13 uuid.UUID `json:"column_13"`
+	Column14 int32     `json:"column_14"`
+	Column15 int32     `json:"column_15"`
+	Column16 int32     `json:"column_16"`
 }
 
 type ListOpenCasesRow struct {
-	ID          uuid.UUID          `json:"id"`
-	ClientID    uuid.UUID          `json:"client_id"`
-	Title       string             `json:"title"`
-	Category    string             `json:"category"`
-	Description string             `json:"description"`
-	Status      string             `json:"status"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
-	ClientName  pgtype.Text        `json:"client_name"`
+	ID                  uuid.UUID          `json:"id"`
+	ClientID            uuid.UUID          `json:"client_id"`
+	Title               string             `json:"title"`
+	Category            string             `json:"category"`
+	Description         string             `json:"description"`
+	Status              string             `json:"status"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	RedactedDescription string             `json:"redacted_description"`
+	Jurisdiction        pgtype.Text        `json:"jurisdiction"`
+	Deadline            pgtype.Timestamptz `json:"deadline"`
+	RedactedAt          pgtype.Timestamptz `json:"redacted_at"`
+	RedactionVersion    int32              `json:"redaction_version"`
+	ClientName          pgtype.Text        `json:"client_name"`
+	ClientEmail         string             `json:"client_email"`
+	QuoteCount          int32              `json:"quote_count"`
+	Rank                float32            `json:"rank"`
+	Snippet             string             `json:"snippet"`
+	SortKey             float64            `json:"sort_key"`
 }
 
 func (q *Queries) ListOpenCases(ctx context.Context, arg *ListOpenCasesParams) ([]*ListOpenCasesRow, error) {
@@ -215,6 +416,18 @@ func (q *Queries) ListOpenCases(ctx context.Context, arg *ListOpenCasesParams) (
 		arg.Column2,
 		arg.Limit,
 		arg.Offset,
+		arg.Column5,
+		arg.Column6,
+		arg.Column7,
+		arg.Column8,
+		arg.Column9,
+		arg.Column10,
+		arg.Column11,
+		arg.Column12,
+		arg.Column13,
+		arg.Column14,
+		arg.Column15,
+		arg.Column16,
 	)
 	if err != nil {
 		return nil, err
@@ -232,7 +445,17 @@ func (q *Queries) ListOpenCases(ctx context.Context, arg *ListOpenCasesParams) (
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.RedactedDescription,
+			&i.Jurisdiction,
+			&i.Deadline,
+			&i.RedactedAt,
+			&i.RedactionVersion,
 			&i.ClientName,
+			&i.ClientEmail,
+			&i.QuoteCount,
+			&i.Rank,
+			&i.Snippet,
+			&i.SortKey,
 		); err != nil {
 			return nil, err
 		}
@@ -244,11 +467,123 @@ func (q *Queries) ListOpenCases(ctx context.Context, arg *ListOpenCasesParams) (
 	return items, nil
 }
 
+const TransitionCaseStatus = `-- name: TransitionCaseStatus :one
+UPDATE cases
+SET status = $1, updated_at = NOW()
+WHERE id = $2 AND status = $3
+RETURNING id, client_id, title, category, description, status, created_at, updated_at, redacted_description, jurisdiction, deadline, redacted_at, redaction_version
+`
+
+type TransitionCaseStatusParams struct {
+	ToStatus   string    `json:"to_status"`
+	ID         uuid.UUID `json:"id"`
+	FromStatus string    `json:"from_status"`
+}
+
+func (q *Queries) TransitionCaseStatus(ctx context.Context, arg *TransitionCaseStatusParams) (*Case, error) {
+	row := q.db.QueryRow(ctx, TransitionCaseStatus, arg.ToStatus, arg.ID, arg.FromStatus)
+	var i Case
+	err := row.Scan(
+		&i.ID,
+		&i.ClientID,
+		&i.Title,
+		&i.Category,
+		&i.Description,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RedactedDescription,
+		&i.Jurisdiction,
+		&i.Deadline,
+		&i.RedactedAt,
+		&i.RedactionVersion,
+	)
+	return &i, err
+}
+
+const UpdateCaseDetails = `-- name: UpdateCaseDetails :one
+UPDATE cases
+SET title = $2, category = $3, description = $4, redacted_description = $5, redaction_version = $6, redacted_at = NOW(), updated_at = NOW()
+WHERE id = $1 AND status = 'open'
+RETURNING id, client_id, title, category, description, status, created_at, updated_at, redacted_description, jurisdiction, deadline, redacted_at, redaction_version
+`
+
+type UpdateCaseDetailsParams struct {
+	ID                  uuid.UUID `json:"id"`
+	Title               string    `json:"title"`
+	Category            string    `json:"category"`
+	Description         string    `json:"description"`
+	RedactedDescription string    `json:"redacted_description"`
+	RedactionVersion    int32     `json:"redaction_version"`
+}
+
+func (q *Queries) UpdateCaseDetails(ctx context.Context, arg *UpdateCaseDetailsParams) (*Case, error) {
+	row := q.db.QueryRow(ctx, UpdateCaseDetails,
+		arg.ID,
+		arg.Title,
+		arg.Category,
+		arg.Description,
+		arg.RedactedDescription,
+		arg.RedactionVersion,
+	)
+	var i Case
+	err := row.Scan(
+		&i.ID,
+		&i.ClientID,
+		&i.Title,
+		&i.Category,
+		&i.Description,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RedactedDescription,
+		&i.Jurisdiction,
+		&i.Deadline,
+		&i.RedactedAt,
+		&i.RedactionVersion,
+	)
+	return &i, err
+}
+
+const UpdateCaseRedactedDescription = `-- name: UpdateCaseRedactedDescription :one
+UPDATE cases
+SET redacted_description = $2, redaction_version = $3, redacted_at = NOW()
+WHERE id = $1
+RETURNING id, client_id, title, category, description, status, created_at, updated_at, redacted_description, jurisdiction, deadline, redacted_at, redaction_version
+`
+
+type UpdateCaseRedactedDescriptionParams struct {
+	ID                  uuid.UUID `json:"id"`
+	RedactedDescription string    `json:"redacted_description"`
+	RedactionVersion    int32     `json:"redaction_version"`
+}
+
+func (q *Queries) UpdateCaseRedactedDescription(ctx context.Context, arg *UpdateCaseRedactedDescriptionParams) (*Case, error) {
+	row := q.db.QueryRow(ctx, UpdateCaseRedactedDescription, arg.ID, arg.RedactedDescription, arg.RedactionVersion)
+	var i Case
+	err := row.Scan(
+		&i.ID,
+		&i.ClientID,
+		&i.Title,
+		&i.Category,
+		&i.Description,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RedactedDescription,
+		&i.Jurisdiction,
+		&i.Deadline,
+		&i.RedactedAt,
+		&i.RedactionVersion,
+	)
+	return &i, err
+}
+
 const UpdateCaseStatus = `-- name: UpdateCaseStatus :one
 UPDATE cases
 SET status = $2, updated_at = NOW()
 WHERE id = $1
-RETURNING id, client_id, title, category, description, status, created_at, updated_at
+RETURNING id, client_id, title, category, description, status, created_at, updated_at, redacted_description, jurisdiction, deadline, redacted_at, redaction_version
 `
 
 type UpdateCaseStatusParams struct {
@@ -268,6 +603,11 @@ func (q *Queries) UpdateCaseStatus(ctx context.Context, arg *UpdateCaseStatusPar
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.RedactedDescription,
+		&i.Jurisdiction,
+		&i.Deadline,
+		&i.RedactedAt,
+		&i.RedactionVersion,
 	)
 	return &i, err
 }
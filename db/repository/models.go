@@ -5,19 +5,36 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AdminAction struct {
+	ID         uuid.UUID          `json:"id"`
+	AdminID    uuid.UUID          `json:"admin_id"`
+	Action     string             `json:"action"`
+	TargetType string             `json:"target_type"`
+	TargetID   uuid.UUID          `json:"target_id"`
+	Reason     pgtype.Text        `json:"reason"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
 type Case struct {
-	ID          uuid.UUID          `json:"id"`
-	ClientID    uuid.UUID          `json:"client_id"`
-	Title       string             `json:"title"`
-	Category    string             `json:"category"`
-	Description string             `json:"description"`
-	Status      string             `json:"status"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	ID                  uuid.UUID          `json:"id"`
+	ClientID            uuid.UUID          `json:"client_id"`
+	Title               string             `json:"title"`
+	Category            string             `json:"category"`
+	Description         string             `json:"description"`
+	Status              string             `json:"status"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt           pgtype.Timestamptz `json:"updated_at"`
+	RedactedDescription string             `json:"redacted_description"`
+	Jurisdiction        pgtype.Text        `json:"jurisdiction"`
+	Deadline            pgtype.Timestamptz `json:"deadline"`
+	RedactedAt          pgtype.Timestamptz `json:"redacted_at"`
+	RedactionVersion    int32              `json:"redaction_version"`
 }
 
 type CaseFile struct {
@@ -30,6 +47,122 @@ type CaseFile struct {
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
 }
 
+type CaseManualRedaction struct {
+	ID        uuid.UUID          `json:"id"`
+	CaseID    uuid.UUID          `json:"case_id"`
+	Phrase    string             `json:"phrase"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type CaseRedaction struct {
+	ID          uuid.UUID          `json:"id"`
+	CaseID      uuid.UUID          `json:"case_id"`
+	Detector    string             `json:"detector"`
+	StartOffset int32              `json:"start_offset"`
+	EndOffset   int32              `json:"end_offset"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type CaseReview struct {
+	ID        uuid.UUID          `json:"id"`
+	CaseID    uuid.UUID          `json:"case_id"`
+	LawyerID  uuid.UUID          `json:"lawyer_id"`
+	ClientID  uuid.UUID          `json:"client_id"`
+	Rating    int32              `json:"rating"`
+	Comment   pgtype.Text        `json:"comment"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type CaseRevision struct {
+	ID                  uuid.UUID          `json:"id"`
+	CaseID              uuid.UUID          `json:"case_id"`
+	Revision            int32              `json:"revision"`
+	Title               string             `json:"title"`
+	Category            string             `json:"category"`
+	Description         string             `json:"description"`
+	ChangedFields       []string           `json:"changed_fields"`
+	EditedBy            pgtype.UUID        `json:"edited_by"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+	RedactedDescription pgtype.Text        `json:"redacted_description"`
+}
+
+type CaseStatusHistory struct {
+	ID         uuid.UUID          `json:"id"`
+	CaseID     uuid.UUID          `json:"case_id"`
+	FromStatus pgtype.Text        `json:"from_status"`
+	ToStatus   string             `json:"to_status"`
+	ActorID    pgtype.UUID        `json:"actor_id"`
+	ActorRole  string             `json:"actor_role"`
+	Reason     pgtype.Text        `json:"reason"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type CaseView struct {
+	CaseID   uuid.UUID `json:"case_id"`
+	UserID   uuid.UUID `json:"user_id"`
+	ViewedAt time.Time `json:"viewed_at"`
+}
+
+type Category struct {
+	ID        uuid.UUID          `json:"id"`
+	Slug      string             `json:"slug"`
+	Name      string             `json:"name"`
+	ParentID  pgtype.UUID        `json:"parent_id"`
+	IsActive  bool               `json:"is_active"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type EmailVerificationToken struct {
+	ID        uuid.UUID          `json:"id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	TokenHash string             `json:"token_hash"`
+	ExpiresAt time.Time          `json:"expires_at"`
+	UsedAt    pgtype.Timestamptz `json:"used_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type LawyerVerification struct {
+	ID           uuid.UUID          `json:"id"`
+	LawyerID     uuid.UUID          `json:"lawyer_id"`
+	Jurisdiction pgtype.Text        `json:"jurisdiction"`
+	BarNumber    pgtype.Text        `json:"bar_number"`
+	Status       string             `json:"status"`
+	ReviewedBy   pgtype.UUID        `json:"reviewed_by"`
+	ReviewNote   pgtype.Text        `json:"review_note"`
+	ReviewedAt   pgtype.Timestamptz `json:"reviewed_at"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+}
+
+type LawyerVerificationDocument struct {
+	ID             uuid.UUID          `json:"id"`
+	VerificationID uuid.UUID          `json:"verification_id"`
+	FileName       string             `json:"file_name"`
+	FilePath       string             `json:"file_path"`
+	FileSize       int64              `json:"file_size"`
+	MimeType       string             `json:"mime_type"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type Notification struct {
+	ID        uuid.UUID          `json:"id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	Type      string             `json:"type"`
+	Data      []byte             `json:"data"`
+	ReadAt    pgtype.Timestamptz `json:"read_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type PasswordResetToken struct {
+	ID        uuid.UUID          `json:"id"`
+	UserID    uuid.UUID          `json:"user_id"`
+	TokenHash string             `json:"token_hash"`
+	ExpiresAt time.Time          `json:"expires_at"`
+	UsedAt    pgtype.Timestamptz `json:"used_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
 type Payment struct {
 	ID                    uuid.UUID          `json:"id"`
 	QuoteID               uuid.UUID          `json:"quote_id"`
@@ -38,28 +171,116 @@ type Payment struct {
 	Status                string             `json:"status"`
 	CreatedAt             pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
+	MilestoneID           pgtype.UUID        `json:"milestone_id"`
+	Kind                  string             `json:"kind"`
 }
 
 type Quote struct {
+	ID                uuid.UUID          `json:"id"`
+	CaseID            uuid.UUID          `json:"case_id"`
+	LawyerID          uuid.UUID          `json:"lawyer_id"`
+	Amount            pgtype.Numeric     `json:"amount"`
+	ExpectedDays      int32              `json:"expected_days"`
+	Note              pgtype.Text        `json:"note"`
+	Status            string             `json:"status"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+	ValidUntil        pgtype.Timestamptz `json:"valid_until"`
+	DeclineReason     pgtype.Text        `json:"decline_reason"`
+	RebidAllowed      bool               `json:"rebid_allowed"`
+	AgreedAmount      pgtype.Numeric     `json:"agreed_amount"`
+	AgreedDays        pgtype.Int4        `json:"agreed_days"`
+	PricingModel      string             `json:"pricing_model"`
+	HourlyRate        pgtype.Numeric     `json:"hourly_rate"`
+	EstimatedHoursMin pgtype.Numeric     `json:"estimated_hours_min"`
+	EstimatedHoursMax pgtype.Numeric     `json:"estimated_hours_max"`
+	CapAmount         pgtype.Numeric     `json:"cap_amount"`
+	DepositAmount     pgtype.Numeric     `json:"deposit_amount"`
+}
+
+type QuoteMilestone struct {
+	ID          uuid.UUID          `json:"id"`
+	QuoteID     uuid.UUID          `json:"quote_id"`
+	Position    int32              `json:"position"`
+	Title       string             `json:"title"`
+	Amount      pgtype.Numeric     `json:"amount"`
+	DueDate     pgtype.Timestamptz `json:"due_date"`
+	Status      string             `json:"status"`
+	PaidAt      pgtype.Timestamptz `json:"paid_at"`
+	DeliveredAt pgtype.Timestamptz `json:"delivered_at"`
+	ApprovedAt  pgtype.Timestamptz `json:"approved_at"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type QuoteOffer struct {
 	ID           uuid.UUID          `json:"id"`
-	CaseID       uuid.UUID          `json:"case_id"`
-	LawyerID     uuid.UUID          `json:"lawyer_id"`
+	QuoteID      uuid.UUID          `json:"quote_id"`
+	ProposedBy   uuid.UUID          `json:"proposed_by"`
+	ProposerRole string             `json:"proposer_role"`
 	Amount       pgtype.Numeric     `json:"amount"`
 	ExpectedDays int32              `json:"expected_days"`
 	Note         pgtype.Text        `json:"note"`
 	Status       string             `json:"status"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+	RespondedAt  pgtype.Timestamptz `json:"responded_at"`
 }
 
-type User struct {
+type QuoteRevision struct {
+	ID                uuid.UUID          `json:"id"`
+	QuoteID           uuid.UUID          `json:"quote_id"`
+	Revision          int32              `json:"revision"`
+	Amount            pgtype.Numeric     `json:"amount"`
+	ExpectedDays      int32              `json:"expected_days"`
+	Note              pgtype.Text        `json:"note"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	PricingModel      string             `json:"pricing_model"`
+	HourlyRate        pgtype.Numeric     `json:"hourly_rate"`
+	EstimatedHoursMin pgtype.Numeric     `json:"estimated_hours_min"`
+	EstimatedHoursMax pgtype.Numeric     `json:"estimated_hours_max"`
+	CapAmount         pgtype.Numeric     `json:"cap_amount"`
+	DepositAmount     pgtype.Numeric     `json:"deposit_amount"`
+	Milestones        []byte             `json:"milestones"`
+}
+
+type RotatedRefreshToken struct {
+	TokenHash string             `json:"token_hash"`
+	SessionID uuid.UUID          `json:"session_id"`
+	RotatedAt pgtype.Timestamptz `json:"rotated_at"`
+}
+
+type SavedSearch struct {
 	ID           uuid.UUID          `json:"id"`
-	Email        string             `json:"email"`
-	PasswordHash string             `json:"password_hash"`
-	Name         pgtype.Text        `json:"name"`
-	Role         string             `json:"role"`
+	LawyerID     uuid.UUID          `json:"lawyer_id"`
+	Name         string             `json:"name"`
+	Category     pgtype.Text        `json:"category"`
+	Query        pgtype.Text        `json:"query"`
 	Jurisdiction pgtype.Text        `json:"jurisdiction"`
-	BarNumber    pgtype.Text        `json:"bar_number"`
 	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Session struct {
+	ID               uuid.UUID          `json:"id"`
+	UserID           uuid.UUID          `json:"user_id"`
+	RefreshTokenHash string             `json:"refresh_token_hash"`
+	UserAgent        pgtype.Text        `json:"user_agent"`
+	IpAddress        pgtype.Text        `json:"ip_address"`
+	ExpiresAt        time.Time          `json:"expires_at"`
+	RevokedAt        pgtype.Timestamptz `json:"revoked_at"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+}
+
+type User struct {
+	ID               uuid.UUID          `json:"id"`
+	Email            string             `json:"email"`
+	PasswordHash     string             `json:"password_hash"`
+	Name             pgtype.Text        `json:"name"`
+	Role             string             `json:"role"`
+	Jurisdiction     pgtype.Text        `json:"jurisdiction"`
+	BarNumber        pgtype.Text        `json:"bar_number"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	EmailVerifiedAt  pgtype.Timestamptz `json:"email_verified_at"`
+	SuspendedAt      pgtype.Timestamptz `json:"suspended_at"`
+	SuspensionReason pgtype.Text        `json:"suspension_reason"`
 }
@@ -12,10 +12,96 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const CancelPendingPaymentsByCaseID = `-- name: CancelPendingPaymentsByCaseID :many
+UPDATE payments
+SET status = 'canceled', updated_at = NOW()
+WHERE status = 'pending'
+  AND quote_id IN (SELECT id FROM quotes WHERE case_id = $1)
+RETURNING id, quote_id, stripe_payment_intent_id, amount, status, created_at, updated_at, milestone_id, kind
+`
+
+func (q *Queries) CancelPendingPaymentsByCaseID(ctx context.Context, caseID uuid.UUID) ([]*Payment, error) {
+	rows, err := q.db.Query(ctx, CancelPendingPaymentsByCaseID, caseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Payment{}
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.ID,
+			&i.QuoteID,
+			&i.StripePaymentIntentID,
+			&i.Amount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.MilestoneID,
+			&i.Kind,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const CancelPendingPaymentsByQuoteID = `-- name: CancelPendingPaymentsByQuoteID :many
+UPDATE payments
+SET status = 'canceled', updated_at = NOW()
+WHERE quote_id = $1 AND status = 'pending'
+RETURNING id, quote_id, stripe_payment_intent_id, amount, status, created_at, updated_at, milestone_id, kind
+`
+
+func (q *Queries) CancelPendingPaymentsByQuoteID(ctx context.Context, quoteID uuid.UUID) ([]*Payment, error) {
+	rows, err := q.db.Query(ctx, CancelPendingPaymentsByQuoteID, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Payment{}
+	for rows.Next() {
+		var i Payment
+		if err := rows.Scan(
+			&i.ID,
+			&i.QuoteID,
+			&i.StripePaymentIntentID,
+			&i.Amount,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.MilestoneID,
+			&i.Kind,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const CountPendingPaymentsByQuoteID = `-- name: CountPendingPaymentsByQuoteID :one
+SELECT COUNT(*) FROM payments WHERE quote_id = $1 AND status = 'pending'
+`
+
+func (q *Queries) CountPendingPaymentsByQuoteID(ctx context.Context, quoteID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, CountPendingPaymentsByQuoteID, quoteID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const CreatePayment = `-- name: CreatePayment :one
-INSERT INTO payments (quote_id, stripe_payment_intent_id, amount, status)
-VALUES ($1, $2, $3, $4)
-RETURNING id, quote_id, stripe_payment_intent_id, amount, status, created_at, updated_at
+INSERT INTO payments (quote_id, stripe_payment_intent_id, amount, status, milestone_id, kind)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, quote_id, stripe_payment_intent_id, amount, status, created_at, updated_at, milestone_id, kind
 `
 
 type CreatePaymentParams struct {
@@ -23,6 +109,8 @@ type CreatePaymentParams struct {
 	StripePaymentIntentID string         `json:"stripe_payment_intent_id"`
 	Amount                pgtype.Numeric `json:"amount"`
 	Status                string         `json:"status"`
+	MilestoneID           pgtype.UUID    `json:"milestone_id"`
+	Kind                  string         `json:"kind"`
 }
 
 func (q *Queries) CreatePayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error) {
@@ -31,6 +119,8 @@ func (q *Queries) CreatePayment(ctx context.Context, arg *CreatePaymentParams) (
 		arg.StripePaymentIntentID,
 		arg.Amount,
 		arg.Status,
+		arg.MilestoneID,
+		arg.Kind,
 	)
 	var i Payment
 	err := row.Scan(
@@ -41,12 +131,14 @@ func (q *Queries) CreatePayment(ctx context.Context, arg *CreatePaymentParams) (
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MilestoneID,
+		&i.Kind,
 	)
 	return &i, err
 }
 
 const GetPaymentByID = `-- name: GetPaymentByID :one
-SELECT id, quote_id, stripe_payment_intent_id, amount, status, created_at, updated_at FROM payments WHERE id = $1
+SELECT id, quote_id, stripe_payment_intent_id, amount, status, created_at, updated_at, milestone_id, kind FROM payments WHERE id = $1
 `
 
 func (q *Queries) GetPaymentByID(ctx context.Context, id uuid.UUID) (*Payment, error) {
@@ -60,12 +152,14 @@ func (q *Queries) GetPaymentByID(ctx context.Context, id uuid.UUID) (*Payment, e
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MilestoneID,
+		&i.Kind,
 	)
 	return &i, err
 }
 
 const GetPaymentByQuoteID = `-- name: GetPaymentByQuoteID :one
-SELECT id, quote_id, stripe_payment_intent_id, amount, status, created_at, updated_at FROM payments WHERE quote_id = $1 LIMIT 1
+SELECT id, quote_id, stripe_payment_intent_id, amount, status, created_at, updated_at, milestone_id, kind FROM payments WHERE quote_id = $1 LIMIT 1
 `
 
 func (q *Queries) GetPaymentByQuoteID(ctx context.Context, quoteID uuid.UUID) (*Payment, error) {
@@ -79,12 +173,14 @@ func (q *Queries) GetPaymentByQuoteID(ctx context.Context, quoteID uuid.UUID) (*
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MilestoneID,
+		&i.Kind,
 	)
 	return &i, err
 }
 
 const GetPaymentByStripePaymentIntentID = `-- name: GetPaymentByStripePaymentIntentID :one
-SELECT id, quote_id, stripe_payment_intent_id, amount, status, created_at, updated_at FROM payments WHERE stripe_payment_intent_id = $1
+SELECT id, quote_id, stripe_payment_intent_id, amount, status, created_at, updated_at, milestone_id, kind FROM payments WHERE stripe_payment_intent_id = $1
 `
 
 func (q *Queries) GetPaymentByStripePaymentIntentID(ctx context.Context, stripePaymentIntentID string) (*Payment, error) {
@@ -98,15 +194,29 @@ func (q *Queries) GetPaymentByStripePaymentIntentID(ctx context.Context, stripeP
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MilestoneID,
+		&i.Kind,
 	)
 	return &i, err
 }
 
+const SumActivePaymentsByQuoteID = `-- name: SumActivePaymentsByQuoteID :one
+SELECT COALESCE(SUM(amount), 0)::NUMERIC AS total FROM payments
+WHERE quote_id = $1 AND status IN ('pending', 'succeeded')
+`
+
+func (q *Queries) SumActivePaymentsByQuoteID(ctx context.Context, quoteID uuid.UUID) (pgtype.Numeric, error) {
+	row := q.db.QueryRow(ctx, SumActivePaymentsByQuoteID, quoteID)
+	var total pgtype.Numeric
+	err := row.Scan(&total)
+	return total, err
+}
+
 const UpdatePaymentStatus = `-- name: UpdatePaymentStatus :one
 UPDATE payments
 SET status = $2, updated_at = NOW()
 WHERE id = $1
-RETURNING id, quote_id, stripe_payment_intent_id, amount, status, created_at, updated_at
+RETURNING id, quote_id, stripe_payment_intent_id, amount, status, created_at, updated_at, milestone_id, kind
 `
 
 type UpdatePaymentStatusParams struct {
@@ -125,6 +235,8 @@ func (q *Queries) UpdatePaymentStatus(ctx context.Context, arg *UpdatePaymentSta
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MilestoneID,
+		&i.Kind,
 	)
 	return &i, err
 }
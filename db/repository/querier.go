@@ -8,42 +8,143 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
 	AcceptQuote(ctx context.Context, id uuid.UUID) (*Quote, error)
+	AllowQuoteRebid(ctx context.Context, id uuid.UUID) (*Quote, error)
+	ApproveQuoteMilestone(ctx context.Context, id uuid.UUID) (*QuoteMilestone, error)
+	CancelPendingPaymentsByCaseID(ctx context.Context, caseID uuid.UUID) ([]*Payment, error)
+	CancelPendingPaymentsByQuoteID(ctx context.Context, quoteID uuid.UUID) ([]*Payment, error)
+	CloseQuoteOffer(ctx context.Context, arg *CloseQuoteOfferParams) (*QuoteOffer, error)
+	CompareQuotesByCaseID(ctx context.Context, caseID uuid.UUID) ([]*CompareQuotesByCaseIDRow, error)
+	CountAdminActions(ctx context.Context, column1 string) (int64, error)
 	CountCaseFilesByCaseID(ctx context.Context, caseID uuid.UUID) (int64, error)
 	CountCasesByClientID(ctx context.Context, clientID uuid.UUID) (int64, error)
+	CountEmailVerificationTokensSince(ctx context.Context, arg *CountEmailVerificationTokensSinceParams) (int64, error)
+	CountLawyerVerificationDocuments(ctx context.Context, verificationID uuid.UUID) (int64, error)
+	CountLawyerVerifications(ctx context.Context, column1 string) (int64, error)
+	CountNotificationsByUserID(ctx context.Context, arg *CountNotificationsByUserIDParams) (int64, error)
 	CountOpenCases(ctx context.Context, arg *CountOpenCasesParams) (int64, error)
+	CountPendingPaymentsByQuoteID(ctx context.Context, quoteID uuid.UUID) (int64, error)
 	CountQuotesByCaseID(ctx context.Context, caseID uuid.UUID) (int64, error)
 	CountQuotesByLawyerID(ctx context.Context, arg *CountQuotesByLawyerIDParams) (int64, error)
+	CountSavedSearchesByLawyerID(ctx context.Context, lawyerID uuid.UUID) (int64, error)
+	CountUsers(ctx context.Context, arg *CountUsersParams) (int64, error)
+	CreateAdminAction(ctx context.Context, arg *CreateAdminActionParams) (*AdminAction, error)
 	CreateCase(ctx context.Context, arg *CreateCaseParams) (*Case, error)
 	CreateCaseFile(ctx context.Context, arg *CreateCaseFileParams) (*CaseFile, error)
+	CreateCaseManualRedaction(ctx context.Context, arg *CreateCaseManualRedactionParams) (*CaseManualRedaction, error)
+	CreateCaseRedaction(ctx context.Context, arg *CreateCaseRedactionParams) (*CaseRedaction, error)
+	CreateCaseReview(ctx context.Context, arg *CreateCaseReviewParams) (*CaseReview, error)
+	CreateCaseRevision(ctx context.Context, arg *CreateCaseRevisionParams) (*CaseRevision, error)
+	CreateCaseStatusHistory(ctx context.Context, arg *CreateCaseStatusHistoryParams) (*CaseStatusHistory, error)
+	CreateEmailVerificationToken(ctx context.Context, arg *CreateEmailVerificationTokenParams) (*EmailVerificationToken, error)
+	CreateLawyerVerification(ctx context.Context, arg *CreateLawyerVerificationParams) (*LawyerVerification, error)
+	CreateLawyerVerificationDocument(ctx context.Context, arg *CreateLawyerVerificationDocumentParams) (*LawyerVerificationDocument, error)
+	CreateNotification(ctx context.Context, arg *CreateNotificationParams) (*Notification, error)
+	CreatePasswordResetToken(ctx context.Context, arg *CreatePasswordResetTokenParams) (*PasswordResetToken, error)
 	CreatePayment(ctx context.Context, arg *CreatePaymentParams) (*Payment, error)
 	CreateQuote(ctx context.Context, arg *CreateQuoteParams) (*Quote, error)
+	CreateQuoteMilestone(ctx context.Context, arg *CreateQuoteMilestoneParams) (*QuoteMilestone, error)
+	CreateQuoteOffer(ctx context.Context, arg *CreateQuoteOfferParams) (*QuoteOffer, error)
+	CreateQuoteRevision(ctx context.Context, arg *CreateQuoteRevisionParams) (*QuoteRevision, error)
+	CreateRotatedRefreshToken(ctx context.Context, arg *CreateRotatedRefreshTokenParams) error
+	CreateSavedSearch(ctx context.Context, arg *CreateSavedSearchParams) (*SavedSearch, error)
+	CreateSession(ctx context.Context, arg *CreateSessionParams) (*Session, error)
 	CreateUser(ctx context.Context, arg *CreateUserParams) (*User, error)
+	DeclineQuote(ctx context.Context, arg *DeclineQuoteParams) (*Quote, error)
 	DeleteCaseFile(ctx context.Context, id uuid.UUID) error
+	DeleteCaseManualRedactions(ctx context.Context, caseID uuid.UUID) error
+	DeleteCaseRedactions(ctx context.Context, caseID uuid.UUID) error
+	DeleteQuoteMilestones(ctx context.Context, quoteID uuid.UUID) error
+	DeleteSavedSearch(ctx context.Context, arg *DeleteSavedSearchParams) (*SavedSearch, error)
+	ExpireStaleQuotes(ctx context.Context, column1 int32) ([]*Quote, error)
 	GetAcceptedQuoteByCaseID(ctx context.Context, caseID uuid.UUID) (*Quote, error)
 	GetCaseByID(ctx context.Context, id uuid.UUID) (*Case, error)
+	GetCaseByIDForUpdate(ctx context.Context, id uuid.UUID) (*Case, error)
 	GetCaseFileByID(ctx context.Context, id uuid.UUID) (*CaseFile, error)
 	GetCaseFilesByCaseID(ctx context.Context, caseID uuid.UUID) ([]*CaseFile, error)
+	GetCaseManualRedactions(ctx context.Context, caseID uuid.UUID) ([]*CaseManualRedaction, error)
+	GetCaseRedactions(ctx context.Context, caseID uuid.UUID) ([]*CaseRedaction, error)
+	GetCaseReviewByCaseID(ctx context.Context, caseID uuid.UUID) (*CaseReview, error)
+	GetCaseRevisions(ctx context.Context, caseID uuid.UUID) ([]*CaseRevision, error)
+	GetCaseStatusHistory(ctx context.Context, caseID uuid.UUID) ([]*GetCaseStatusHistoryRow, error)
+	GetCaseView(ctx context.Context, arg *GetCaseViewParams) (*CaseView, error)
 	GetCaseWithClient(ctx context.Context, id uuid.UUID) (*GetCaseWithClientRow, error)
 	GetCasesByClientID(ctx context.Context, arg *GetCasesByClientIDParams) ([]*Case, error)
+	GetCategoryBySlug(ctx context.Context, slug string) (*Category, error)
+	GetEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (*EmailVerificationToken, error)
+	GetLatestCaseRevisionNumber(ctx context.Context, caseID uuid.UUID) (int32, error)
+	GetLatestEmailVerificationToken(ctx context.Context, userID uuid.UUID) (*EmailVerificationToken, error)
+	GetLatestLawyerVerificationByLawyerID(ctx context.Context, lawyerID uuid.UUID) (*LawyerVerification, error)
+	GetLatestQuoteRevisionNumber(ctx context.Context, quoteID uuid.UUID) (int32, error)
+	GetLawyerVerificationByID(ctx context.Context, id uuid.UUID) (*GetLawyerVerificationByIDRow, error)
+	GetLawyerVerificationDocuments(ctx context.Context, verificationID uuid.UUID) ([]*LawyerVerificationDocument, error)
+	GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (*PasswordResetToken, error)
 	GetPaymentByID(ctx context.Context, id uuid.UUID) (*Payment, error)
 	GetPaymentByQuoteID(ctx context.Context, quoteID uuid.UUID) (*Payment, error)
 	GetPaymentByStripePaymentIntentID(ctx context.Context, stripePaymentIntentID string) (*Payment, error)
+	GetPendingQuoteOffer(ctx context.Context, quoteID uuid.UUID) (*QuoteOffer, error)
 	GetQuoteByCaseAndLawyer(ctx context.Context, arg *GetQuoteByCaseAndLawyerParams) (*Quote, error)
 	GetQuoteByID(ctx context.Context, id uuid.UUID) (*Quote, error)
+	GetQuoteByIDForUpdate(ctx context.Context, id uuid.UUID) (*Quote, error)
+	GetQuoteMilestoneByID(ctx context.Context, id uuid.UUID) (*QuoteMilestone, error)
+	GetQuoteMilestones(ctx context.Context, quoteID uuid.UUID) ([]*QuoteMilestone, error)
+	GetQuoteMilestonesByCaseID(ctx context.Context, caseID uuid.UUID) ([]*QuoteMilestone, error)
+	GetQuoteOfferByID(ctx context.Context, id uuid.UUID) (*QuoteOffer, error)
+	GetQuoteOffersByQuoteID(ctx context.Context, quoteID uuid.UUID) ([]*QuoteOffer, error)
+	GetQuoteRevisionsByCaseID(ctx context.Context, caseID uuid.UUID) ([]*QuoteRevision, error)
 	GetQuotesByCaseID(ctx context.Context, caseID uuid.UUID) ([]*GetQuotesByCaseIDRow, error)
 	GetQuotesByLawyerID(ctx context.Context, arg *GetQuotesByLawyerIDParams) ([]*GetQuotesByLawyerIDRow, error)
+	GetSessionByID(ctx context.Context, id uuid.UUID) (*Session, error)
+	GetSessionByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (*Session, error)
+	GetSessionIDByRotatedRefreshTokenHash(ctx context.Context, tokenHash string) (uuid.UUID, error)
 	GetUserByEmail(ctx context.Context, email string) (*User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (*User, error)
+	InvalidateUserEmailVerificationTokens(ctx context.Context, userID uuid.UUID) error
+	InvalidateUserPasswordResetTokens(ctx context.Context, userID uuid.UUID) error
+	ListActiveCategories(ctx context.Context) ([]*ListActiveCategoriesRow, error)
+	ListAdminActions(ctx context.Context, arg *ListAdminActionsParams) ([]*ListAdminActionsRow, error)
+	ListCasesWithStaleRedaction(ctx context.Context, arg *ListCasesWithStaleRedactionParams) ([]*Case, error)
+	ListLawyerVerifications(ctx context.Context, arg *ListLawyerVerificationsParams) ([]*ListLawyerVerificationsRow, error)
+	ListNotificationsByUserID(ctx context.Context, arg *ListNotificationsByUserIDParams) ([]*Notification, error)
 	ListOpenCases(ctx context.Context, arg *ListOpenCasesParams) ([]*ListOpenCasesRow, error)
+	ListSavedSearchesByLawyerID(ctx context.Context, lawyerID uuid.UUID) ([]*SavedSearch, error)
+	ListSavedSearchesMatchingCase(ctx context.Context, id uuid.UUID) ([]*SavedSearch, error)
+	ListUsers(ctx context.Context, arg *ListUsersParams) ([]*User, error)
+	MarkAllNotificationsRead(ctx context.Context, userID uuid.UUID) error
+	MarkEmailVerificationTokenUsed(ctx context.Context, id uuid.UUID) (*EmailVerificationToken, error)
+	MarkNotificationRead(ctx context.Context, arg *MarkNotificationReadParams) (*Notification, error)
+	MarkPasswordResetTokenUsed(ctx context.Context, id uuid.UUID) (*PasswordResetToken, error)
+	MarkQuoteMilestoneDelivered(ctx context.Context, id uuid.UUID) (*QuoteMilestone, error)
+	MarkQuoteMilestonePaid(ctx context.Context, id uuid.UUID) (*QuoteMilestone, error)
+	MarkUserEmailVerified(ctx context.Context, id uuid.UUID) (*User, error)
+	ReactivateUser(ctx context.Context, id uuid.UUID) (*User, error)
+	RecordCaseView(ctx context.Context, arg *RecordCaseViewParams) error
 	RejectOtherQuotes(ctx context.Context, arg *RejectOtherQuotesParams) ([]*Quote, error)
+	RejectProposedQuotesByCaseID(ctx context.Context, caseID uuid.UUID) ([]*Quote, error)
+	ReviewLawyerVerification(ctx context.Context, arg *ReviewLawyerVerificationParams) (*LawyerVerification, error)
+	RevokeOtherUserSessions(ctx context.Context, arg *RevokeOtherUserSessionsParams) error
+	RevokeSession(ctx context.Context, id uuid.UUID) error
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID) error
+	RotateSessionRefreshToken(ctx context.Context, arg *RotateSessionRefreshTokenParams) (*Session, error)
+	SetQuoteAgreedTerms(ctx context.Context, arg *SetQuoteAgreedTermsParams) (*Quote, error)
+	SumActivePaymentsByQuoteID(ctx context.Context, quoteID uuid.UUID) (pgtype.Numeric, error)
+	SupersedePendingQuoteOffers(ctx context.Context, quoteID uuid.UUID) error
+	SuspendUser(ctx context.Context, arg *SuspendUserParams) (*User, error)
+	TransitionCaseStatus(ctx context.Context, arg *TransitionCaseStatusParams) (*Case, error)
+	UpdateCaseDetails(ctx context.Context, arg *UpdateCaseDetailsParams) (*Case, error)
+	UpdateCaseRedactedDescription(ctx context.Context, arg *UpdateCaseRedactedDescriptionParams) (*Case, error)
 	UpdateCaseStatus(ctx context.Context, arg *UpdateCaseStatusParams) (*Case, error)
 	UpdatePaymentStatus(ctx context.Context, arg *UpdatePaymentStatusParams) (*Payment, error)
+	UpdatePendingLawyerVerificationCredentials(ctx context.Context, arg *UpdatePendingLawyerVerificationCredentialsParams) (*LawyerVerification, error)
 	UpdateQuote(ctx context.Context, arg *UpdateQuoteParams) (*Quote, error)
 	UpdateUser(ctx context.Context, arg *UpdateUserParams) (*User, error)
+	UpdateUserPassword(ctx context.Context, arg *UpdateUserPasswordParams) error
+	VoidQuote(ctx context.Context, id uuid.UUID) (*Quote, error)
+	WithdrawQuote(ctx context.Context, id uuid.UUID) (*Quote, error)
 }
 
 var _ Querier = (*Queries)(nil)
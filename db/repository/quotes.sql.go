@@ -7,6 +7,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -16,7 +17,7 @@ const AcceptQuote = `-- name: AcceptQuote :one
 UPDATE quotes
 SET status = 'accepted', updated_at = NOW()
 WHERE id = $1
-RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at
+RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount
 `
 
 func (q *Queries) AcceptQuote(ctx context.Context, id uuid.UUID) (*Quote, error) {
@@ -32,10 +33,144 @@ func (q *Queries) AcceptQuote(ctx context.Context, id uuid.UUID) (*Quote, error)
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ValidUntil,
+		&i.DeclineReason,
+		&i.RebidAllowed,
+		&i.AgreedAmount,
+		&i.AgreedDays,
+		&i.PricingModel,
+		&i.HourlyRate,
+		&i.EstimatedHoursMin,
+		&i.EstimatedHoursMax,
+		&i.CapAmount,
+		&i.DepositAmount,
 	)
 	return &i, err
 }
 
+const AllowQuoteRebid = `-- name: AllowQuoteRebid :one
+UPDATE quotes
+SET rebid_allowed = TRUE, updated_at = NOW()
+WHERE id = $1 AND status = 'declined'
+RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount
+`
+
+func (q *Queries) AllowQuoteRebid(ctx context.Context, id uuid.UUID) (*Quote, error) {
+	row := q.db.QueryRow(ctx, AllowQuoteRebid, id)
+	var i Quote
+	err := row.Scan(
+		&i.ID,
+		&i.CaseID,
+		&i.LawyerID,
+		&i.Amount,
+		&i.ExpectedDays,
+		&i.Note,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ValidUntil,
+		&i.DeclineReason,
+		&i.RebidAllowed,
+		&i.AgreedAmount,
+		&i.AgreedDays,
+		&i.PricingModel,
+		&i.HourlyRate,
+		&i.EstimatedHoursMin,
+		&i.EstimatedHoursMax,
+		&i.CapAmount,
+		&i.DepositAmount,
+	)
+	return &i, err
+}
+
+const CompareQuotesByCaseID = `-- name: CompareQuotesByCaseID :many
+SELECT q.id, q.case_id, q.lawyer_id, q.amount, q.expected_days, q.note, q.status, q.created_at, q.updated_at, q.valid_until, q.decline_reason, q.rebid_allowed, q.agreed_amount, q.agreed_days, q.pricing_model, q.hourly_rate, q.estimated_hours_min, q.estimated_hours_max, q.cap_amount, q.deposit_amount, u.name as lawyer_name,
+       (SELECT COUNT(*) FROM quotes aq
+        JOIN cases ac ON aq.case_id = ac.id
+        WHERE aq.lawyer_id = q.lawyer_id AND aq.status = 'accepted' AND ac.status = 'closed') as completed_cases,
+       (SELECT COUNT(*) FROM case_reviews r WHERE r.lawyer_id = q.lawyer_id) as review_count,
+       (SELECT COALESCE(AVG(r.rating), 0)::FLOAT8 FROM case_reviews r WHERE r.lawyer_id = q.lawyer_id) as average_rating,
+       (SELECT COUNT(*) FROM quote_revisions qr WHERE qr.quote_id = q.id) as revision_count
+FROM quotes q
+JOIN users u ON q.lawyer_id = u.id
+WHERE q.case_id = $1
+ORDER BY q.created_at ASC
+`
+
+type CompareQuotesByCaseIDRow struct {
+	ID                uuid.UUID          `json:"id"`
+	CaseID            uuid.UUID          `json:"case_id"`
+	LawyerID          uuid.UUID          `json:"lawyer_id"`
+	Amount            pgtype.Numeric     `json:"amount"`
+	ExpectedDays      int32              `json:"expected_days"`
+	Note              pgtype.Text        `json:"note"`
+	Status            string             `json:"status"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+	ValidUntil        pgtype.Timestamptz `json:"valid_until"`
+	DeclineReason     pgtype.Text        `json:"decline_reason"`
+	RebidAllowed      bool               `json:"rebid_allowed"`
+	AgreedAmount      pgtype.Numeric     `json:"agreed_amount"`
+	AgreedDays        pgtype.Int4        `json:"agreed_days"`
+	PricingModel      string             `json:"pricing_model"`
+	HourlyRate        pgtype.Numeric     `json:"hourly_rate"`
+	EstimatedHoursMin pgtype.Numeric     `json:"estimated_hours_min"`
+	EstimatedHoursMax pgtype.Numeric     `json:"estimated_hours_max"`
+	CapAmount         pgtype.Numeric     `json:"cap_amount"`
+	DepositAmount     pgtype.Numeric     `json:"deposit_amount"`
+	LawyerName        pgtype.Text        `json:"lawyer_name"`
+	CompletedCases    int64              `json:"completed_cases"`
+	ReviewCount       int64              `json:"review_count"`
+	AverageRating     float64            `json:"average_rating"`
+	RevisionCount     int64              `json:"revision_count"`
+}
+
+func (q *Queries) CompareQuotesByCaseID(ctx context.Context, caseID uuid.UUID) ([]*CompareQuotesByCaseIDRow, error) {
+	rows, err := q.db.Query(ctx, CompareQuotesByCaseID, caseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*CompareQuotesByCaseIDRow{}
+	for rows.Next() {
+		var i CompareQuotesByCaseIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CaseID,
+			&i.LawyerID,
+			&i.Amount,
+			&i.ExpectedDays,
+			&i.Note,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ValidUntil,
+			&i.DeclineReason,
+			&i.RebidAllowed,
+			&i.AgreedAmount,
+			&i.AgreedDays,
+			&i.PricingModel,
+			&i.HourlyRate,
+			&i.EstimatedHoursMin,
+			&i.EstimatedHoursMax,
+			&i.CapAmount,
+			&i.DepositAmount,
+			&i.LawyerName,
+			&i.CompletedCases,
+			&i.ReviewCount,
+			&i.AverageRating,
+			&i.RevisionCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const CountQuotesByCaseID = `-- name: CountQuotesByCaseID :one
 SELECT COUNT(*) FROM quotes WHERE case_id = $1
 `
@@ -66,18 +201,26 @@ func (q *Queries) CountQuotesByLawyerID(ctx context.Context, arg *CountQuotesByL
 }
 
 const CreateQuote = `-- name: CreateQuote :one
-INSERT INTO quotes (case_id, lawyer_id, amount, expected_days, note, status)
-VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at
+INSERT INTO quotes (case_id, lawyer_id, amount, expected_days, note, status, valid_until,
+                    pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount
 `
 
 type CreateQuoteParams struct {
-	CaseID       uuid.UUID      `json:"case_id"`
-	LawyerID     uuid.UUID      `json:"lawyer_id"`
-	Amount       pgtype.Numeric `json:"amount"`
-	ExpectedDays int32          `json:"expected_days"`
-	Note         pgtype.Text    `json:"note"`
-	Status       string         `json:"status"`
+	CaseID            uuid.UUID          `json:"case_id"`
+	LawyerID          uuid.UUID          `json:"lawyer_id"`
+	Amount            pgtype.Numeric     `json:"amount"`
+	ExpectedDays      int32              `json:"expected_days"`
+	Note              pgtype.Text        `json:"note"`
+	Status            string             `json:"status"`
+	ValidUntil        pgtype.Timestamptz `json:"valid_until"`
+	PricingModel      string             `json:"pricing_model"`
+	HourlyRate        pgtype.Numeric     `json:"hourly_rate"`
+	EstimatedHoursMin pgtype.Numeric     `json:"estimated_hours_min"`
+	EstimatedHoursMax pgtype.Numeric     `json:"estimated_hours_max"`
+	CapAmount         pgtype.Numeric     `json:"cap_amount"`
+	DepositAmount     pgtype.Numeric     `json:"deposit_amount"`
 }
 
 func (q *Queries) CreateQuote(ctx context.Context, arg *CreateQuoteParams) (*Quote, error) {
@@ -88,6 +231,13 @@ func (q *Queries) CreateQuote(ctx context.Context, arg *CreateQuoteParams) (*Quo
 		arg.ExpectedDays,
 		arg.Note,
 		arg.Status,
+		arg.ValidUntil,
+		arg.PricingModel,
+		arg.HourlyRate,
+		arg.EstimatedHoursMin,
+		arg.EstimatedHoursMax,
+		arg.CapAmount,
+		arg.DepositAmount,
 	)
 	var i Quote
 	err := row.Scan(
@@ -100,12 +250,118 @@ func (q *Queries) CreateQuote(ctx context.Context, arg *CreateQuoteParams) (*Quo
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ValidUntil,
+		&i.DeclineReason,
+		&i.RebidAllowed,
+		&i.AgreedAmount,
+		&i.AgreedDays,
+		&i.PricingModel,
+		&i.HourlyRate,
+		&i.EstimatedHoursMin,
+		&i.EstimatedHoursMax,
+		&i.CapAmount,
+		&i.DepositAmount,
 	)
 	return &i, err
 }
 
+const DeclineQuote = `-- name: DeclineQuote :one
+UPDATE quotes
+SET status = 'declined', decline_reason = $2, rebid_allowed = FALSE, updated_at = NOW()
+WHERE id = $1 AND status = 'proposed'
+  AND NOT EXISTS (SELECT 1 FROM payments WHERE quote_id = $1 AND status = 'pending')
+RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount
+`
+
+type DeclineQuoteParams struct {
+	ID            uuid.UUID   `json:"id"`
+	DeclineReason pgtype.Text `json:"decline_reason"`
+}
+
+func (q *Queries) DeclineQuote(ctx context.Context, arg *DeclineQuoteParams) (*Quote, error) {
+	row := q.db.QueryRow(ctx, DeclineQuote, arg.ID, arg.DeclineReason)
+	var i Quote
+	err := row.Scan(
+		&i.ID,
+		&i.CaseID,
+		&i.LawyerID,
+		&i.Amount,
+		&i.ExpectedDays,
+		&i.Note,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ValidUntil,
+		&i.DeclineReason,
+		&i.RebidAllowed,
+		&i.AgreedAmount,
+		&i.AgreedDays,
+		&i.PricingModel,
+		&i.HourlyRate,
+		&i.EstimatedHoursMin,
+		&i.EstimatedHoursMax,
+		&i.CapAmount,
+		&i.DepositAmount,
+	)
+	return &i, err
+}
+
+const ExpireStaleQuotes = `-- name: ExpireStaleQuotes :many
+UPDATE quotes
+SET status = 'expired', updated_at = NOW()
+WHERE id IN (
+    SELECT sq.id FROM quotes sq
+    WHERE sq.status = 'proposed'
+      AND COALESCE(sq.valid_until, sq.created_at + make_interval(days => $1::INTEGER)) < NOW()
+      AND NOT EXISTS (SELECT 1 FROM payments WHERE quote_id = sq.id AND status = 'pending')
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount
+`
+
+func (q *Queries) ExpireStaleQuotes(ctx context.Context, column1 int32) ([]*Quote, error) {
+	rows, err := q.db.Query(ctx, ExpireStaleQuotes, column1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Quote{}
+	for rows.Next() {
+		var i Quote
+		if err := rows.Scan(
+			&i.ID,
+			&i.CaseID,
+			&i.LawyerID,
+			&i.Amount,
+			&i.ExpectedDays,
+			&i.Note,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ValidUntil,
+			&i.DeclineReason,
+			&i.RebidAllowed,
+			&i.AgreedAmount,
+			&i.AgreedDays,
+			&i.PricingModel,
+			&i.HourlyRate,
+			&i.EstimatedHoursMin,
+			&i.EstimatedHoursMax,
+			&i.CapAmount,
+			&i.DepositAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const GetAcceptedQuoteByCaseID = `-- name: GetAcceptedQuoteByCaseID :one
-SELECT id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at FROM quotes 
+SELECT id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount FROM quotes 
 WHERE case_id = $1 AND status = 'accepted'
 LIMIT 1
 `
@@ -123,12 +379,23 @@ func (q *Queries) GetAcceptedQuoteByCaseID(ctx context.Context, caseID uuid.UUID
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ValidUntil,
+		&i.DeclineReason,
+		&i.RebidAllowed,
+		&i.AgreedAmount,
+		&i.AgreedDays,
+		&i.PricingModel,
+		&i.HourlyRate,
+		&i.EstimatedHoursMin,
+		&i.EstimatedHoursMax,
+		&i.CapAmount,
+		&i.DepositAmount,
 	)
 	return &i, err
 }
 
 const GetQuoteByCaseAndLawyer = `-- name: GetQuoteByCaseAndLawyer :one
-SELECT id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at FROM quotes 
+SELECT id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount FROM quotes 
 WHERE case_id = $1 AND lawyer_id = $2
 `
 
@@ -150,12 +417,23 @@ func (q *Queries) GetQuoteByCaseAndLawyer(ctx context.Context, arg *GetQuoteByCa
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ValidUntil,
+		&i.DeclineReason,
+		&i.RebidAllowed,
+		&i.AgreedAmount,
+		&i.AgreedDays,
+		&i.PricingModel,
+		&i.HourlyRate,
+		&i.EstimatedHoursMin,
+		&i.EstimatedHoursMax,
+		&i.CapAmount,
+		&i.DepositAmount,
 	)
 	return &i, err
 }
 
 const GetQuoteByID = `-- name: GetQuoteByID :one
-SELECT id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at FROM quotes WHERE id = $1
+SELECT id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount FROM quotes WHERE id = $1
 `
 
 func (q *Queries) GetQuoteByID(ctx context.Context, id uuid.UUID) (*Quote, error) {
@@ -171,12 +449,55 @@ func (q *Queries) GetQuoteByID(ctx context.Context, id uuid.UUID) (*Quote, error
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ValidUntil,
+		&i.DeclineReason,
+		&i.RebidAllowed,
+		&i.AgreedAmount,
+		&i.AgreedDays,
+		&i.PricingModel,
+		&i.HourlyRate,
+		&i.EstimatedHoursMin,
+		&i.EstimatedHoursMax,
+		&i.CapAmount,
+		&i.DepositAmount,
+	)
+	return &i, err
+}
+
+const GetQuoteByIDForUpdate = `-- name: GetQuoteByIDForUpdate :one
+SELECT id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount FROM quotes WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetQuoteByIDForUpdate(ctx context.Context, id uuid.UUID) (*Quote, error) {
+	row := q.db.QueryRow(ctx, GetQuoteByIDForUpdate, id)
+	var i Quote
+	err := row.Scan(
+		&i.ID,
+		&i.CaseID,
+		&i.LawyerID,
+		&i.Amount,
+		&i.ExpectedDays,
+		&i.Note,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ValidUntil,
+		&i.DeclineReason,
+		&i.RebidAllowed,
+		&i.AgreedAmount,
+		&i.AgreedDays,
+		&i.PricingModel,
+		&i.HourlyRate,
+		&i.EstimatedHoursMin,
+		&i.EstimatedHoursMax,
+		&i.CapAmount,
+		&i.DepositAmount,
 	)
 	return &i, err
 }
 
 const GetQuotesByCaseID = `-- name: GetQuotesByCaseID :many
-SELECT q.id, q.case_id, q.lawyer_id, q.amount, q.expected_days, q.note, q.status, q.created_at, q.updated_at, u.name as lawyer_name, u.jurisdiction as lawyer_jurisdiction
+SELECT q.id, q.case_id, q.lawyer_id, q.amount, q.expected_days, q.note, q.status, q.created_at, q.updated_at, q.valid_until, q.decline_reason, q.rebid_allowed, q.agreed_amount, q.agreed_days, q.pricing_model, q.hourly_rate, q.estimated_hours_min, q.estimated_hours_max, q.cap_amount, q.deposit_amount, u.name as lawyer_name, u.jurisdiction as lawyer_jurisdiction
 FROM quotes q
 JOIN users u ON q.lawyer_id = u.id
 WHERE q.case_id = $1
@@ -193,6 +514,17 @@ type GetQuotesByCaseIDRow struct {
 	Status             string             `json:"status"`
 	CreatedAt          pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	ValidUntil         pgtype.Timestamptz `json:"valid_until"`
+	DeclineReason      pgtype.Text        `json:"decline_reason"`
+	RebidAllowed       bool               `json:"rebid_allowed"`
+	AgreedAmount       pgtype.Numeric     `json:"agreed_amount"`
+	AgreedDays         pgtype.Int4        `json:"agreed_days"`
+	PricingModel       string             `json:"pricing_model"`
+	HourlyRate         pgtype.Numeric     `json:"hourly_rate"`
+	EstimatedHoursMin  pgtype.Numeric     `json:"estimated_hours_min"`
+	EstimatedHoursMax  pgtype.Numeric     `json:"estimated_hours_max"`
+	CapAmount          pgtype.Numeric     `json:"cap_amount"`
+	DepositAmount      pgtype.Numeric     `json:"deposit_amount"`
 	LawyerName         pgtype.Text        `json:"lawyer_name"`
 	LawyerJurisdiction pgtype.Text        `json:"lawyer_jurisdiction"`
 }
@@ -216,6 +548,17 @@ func (q *Queries) GetQuotesByCaseID(ctx context.Context, caseID uuid.UUID) ([]*G
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ValidUntil,
+			&i.DeclineReason,
+			&i.RebidAllowed,
+			&i.AgreedAmount,
+			&i.AgreedDays,
+			&i.PricingModel,
+			&i.HourlyRate,
+			&i.EstimatedHoursMin,
+			&i.EstimatedHoursMax,
+			&i.CapAmount,
+			&i.DepositAmount,
 			&i.LawyerName,
 			&i.LawyerJurisdiction,
 		); err != nil {
@@ -230,12 +573,13 @@ func (q *Queries) GetQuotesByCaseID(ctx context.Context, caseID uuid.UUID) ([]*G
 }
 
 const GetQuotesByLawyerID = `-- name: GetQuotesByLawyerID :many
-SELECT q.id, q.case_id, q.lawyer_id, q.amount, q.expected_days, q.note, q.status, q.created_at, q.updated_at, c.title as case_title, c.category as case_category, c.status as case_status
+SELECT q.id, q.case_id, q.lawyer_id, q.amount, q.expected_days, q.note, q.status, q.created_at, q.updated_at, q.valid_until, q.decline_reason, q.rebid_allowed, q.agreed_amount, q.agreed_days, q.pricing_model, q.hourly_rate, q.estimated_hours_min, q.estimated_hours_max, q.cap_amount, q.deposit_amount, c.title as case_title, c.category as case_category, c.status as case_status
 FROM quotes q
 JOIN cases c ON q.case_id = c.id
 WHERE q.lawyer_id = $1
   AND ($2::VARCHAR IS NULL OR $2::VARCHAR = '' OR q.status = $2)
-ORDER BY q.created_at DESC
+  AND ($5::BOOLEAN = FALSE OR (q.created_at, q.id) < ($6::TIMESTAMPTZ, $7::UUID))
+ORDER BY q.created_at DESC, q.id DESC
 LIMIT $3 OFFSET $4
 `
 
@@ -244,21 +588,35 @@ type GetQuotesByLawyerIDParams struct {
 	Column2  string    `json:"column_2"`
 	Limit    int32     `json:"limit"`
 	Offset   int32     `json:"offset"`
+	Column5  bool      `json:"column_5"`
+	Column6  time.Time `json:"column_6"`
+	Column7  uuid.UUID `json:"column_7"`
 }
 
 type GetQuotesByLawyerIDRow struct {
-	ID           uuid.UUID          `json:"id"`
-	CaseID       uuid.UUID          `json:"case_id"`
-	LawyerID     uuid.UUID          `json:"lawyer_id"`
-	Amount       pgtype.Numeric     `json:"amount"`
-	ExpectedDays int32              `json:"expected_days"`
-	Note         pgtype.Text        `json:"note"`
-	Status       string             `json:"status"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
-	CaseTitle    string             `json:"case_title"`
-	CaseCategory string             `json:"case_category"`
-	CaseStatus   string             `json:"case_status"`
+	ID                uuid.UUID          `json:"id"`
+	CaseID            uuid.UUID          `json:"case_id"`
+	LawyerID          uuid.UUID          `json:"lawyer_id"`
+	Amount            pgtype.Numeric     `json:"amount"`
+	ExpectedDays      int32              `json:"expected_days"`
+	Note              pgtype.Text        `json:"note"`
+	Status            string             `json:"status"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+	ValidUntil        pgtype.Timestamptz `json:"valid_until"`
+	DeclineReason     pgtype.Text        `json:"decline_reason"`
+	RebidAllowed      bool               `json:"rebid_allowed"`
+	AgreedAmount      pgtype.Numeric     `json:"agreed_amount"`
+	AgreedDays        pgtype.Int4        `json:"agreed_days"`
+	PricingModel      string             `json:"pricing_model"`
+	HourlyRate        pgtype.Numeric     `json:"hourly_rate"`
+	EstimatedHoursMin pgtype.Numeric     `json:"estimated_hours_min"`
+	EstimatedHoursMax pgtype.Numeric     `json:"estimated_hours_max"`
+	CapAmount         pgtype.Numeric     `json:"cap_amount"`
+	DepositAmount     pgtype.Numeric     `json:"deposit_amount"`
+	CaseTitle         string             `json:"case_title"`
+	CaseCategory      string             `json:"case_category"`
+	CaseStatus        string             `json:"case_status"`
 }
 
 func (q *Queries) GetQuotesByLawyerID(ctx context.Context, arg *GetQuotesByLawyerIDParams) ([]*GetQuotesByLawyerIDRow, error) {
@@ -267,6 +625,9 @@ func (q *Queries) GetQuotesByLawyerID(ctx context.Context, arg *GetQuotesByLawye
 		arg.Column2,
 		arg.Limit,
 		arg.Offset,
+		arg.Column5,
+		arg.Column6,
+		arg.Column7,
 	)
 	if err != nil {
 		return nil, err
@@ -285,6 +646,17 @@ func (q *Queries) GetQuotesByLawyerID(ctx context.Context, arg *GetQuotesByLawye
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ValidUntil,
+			&i.DeclineReason,
+			&i.RebidAllowed,
+			&i.AgreedAmount,
+			&i.AgreedDays,
+			&i.PricingModel,
+			&i.HourlyRate,
+			&i.EstimatedHoursMin,
+			&i.EstimatedHoursMax,
+			&i.CapAmount,
+			&i.DepositAmount,
 			&i.CaseTitle,
 			&i.CaseCategory,
 			&i.CaseStatus,
@@ -303,7 +675,7 @@ const RejectOtherQuotes = `-- name: RejectOtherQuotes :many
 UPDATE quotes
 SET status = 'rejected', updated_at = NOW()
 WHERE case_id = $1 AND id != $2 AND status = 'proposed'
-RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at
+RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount
 `
 
 type RejectOtherQuotesParams struct {
@@ -330,6 +702,17 @@ func (q *Queries) RejectOtherQuotes(ctx context.Context, arg *RejectOtherQuotesP
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ValidUntil,
+			&i.DeclineReason,
+			&i.RebidAllowed,
+			&i.AgreedAmount,
+			&i.AgreedDays,
+			&i.PricingModel,
+			&i.HourlyRate,
+			&i.EstimatedHoursMin,
+			&i.EstimatedHoursMax,
+			&i.CapAmount,
+			&i.DepositAmount,
 		); err != nil {
 			return nil, err
 		}
@@ -341,18 +724,119 @@ func (q *Queries) RejectOtherQuotes(ctx context.Context, arg *RejectOtherQuotesP
 	return items, nil
 }
 
+const RejectProposedQuotesByCaseID = `-- name: RejectProposedQuotesByCaseID :many
+UPDATE quotes
+SET status = 'rejected', updated_at = NOW()
+WHERE case_id = $1 AND status = 'proposed'
+RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount
+`
+
+func (q *Queries) RejectProposedQuotesByCaseID(ctx context.Context, caseID uuid.UUID) ([]*Quote, error) {
+	rows, err := q.db.Query(ctx, RejectProposedQuotesByCaseID, caseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Quote{}
+	for rows.Next() {
+		var i Quote
+		if err := rows.Scan(
+			&i.ID,
+			&i.CaseID,
+			&i.LawyerID,
+			&i.Amount,
+			&i.ExpectedDays,
+			&i.Note,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ValidUntil,
+			&i.DeclineReason,
+			&i.RebidAllowed,
+			&i.AgreedAmount,
+			&i.AgreedDays,
+			&i.PricingModel,
+			&i.HourlyRate,
+			&i.EstimatedHoursMin,
+			&i.EstimatedHoursMax,
+			&i.CapAmount,
+			&i.DepositAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const SetQuoteAgreedTerms = `-- name: SetQuoteAgreedTerms :one
+UPDATE quotes
+SET agreed_amount = $2, agreed_days = $3, updated_at = NOW()
+WHERE id = $1 AND status = 'proposed'
+RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount
+`
+
+type SetQuoteAgreedTermsParams struct {
+	ID           uuid.UUID      `json:"id"`
+	AgreedAmount pgtype.Numeric `json:"agreed_amount"`
+	AgreedDays   pgtype.Int4    `json:"agreed_days"`
+}
+
+func (q *Queries) SetQuoteAgreedTerms(ctx context.Context, arg *SetQuoteAgreedTermsParams) (*Quote, error) {
+	row := q.db.QueryRow(ctx, SetQuoteAgreedTerms, arg.ID, arg.AgreedAmount, arg.AgreedDays)
+	var i Quote
+	err := row.Scan(
+		&i.ID,
+		&i.CaseID,
+		&i.LawyerID,
+		&i.Amount,
+		&i.ExpectedDays,
+		&i.Note,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ValidUntil,
+		&i.DeclineReason,
+		&i.RebidAllowed,
+		&i.AgreedAmount,
+		&i.AgreedDays,
+		&i.PricingModel,
+		&i.HourlyRate,
+		&i.EstimatedHoursMin,
+		&i.EstimatedHoursMax,
+		&i.CapAmount,
+		&i.DepositAmount,
+	)
+	return &i, err
+}
+
 const UpdateQuote = `-- name: UpdateQuote :one
 UPDATE quotes
-SET amount = $2, expected_days = $3, note = $4, status = 'proposed', updated_at = NOW()
-WHERE id = $1 AND status != 'accepted'
-RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at
+SET amount = $2, expected_days = $3, note = $4, valid_until = $5, status = 'proposed',
+    pricing_model = $6, hourly_rate = $7, estimated_hours_min = $8, estimated_hours_max = $9,
+    cap_amount = $10, deposit_amount = $11,
+    decline_reason = NULL, rebid_allowed = FALSE, agreed_amount = NULL, agreed_days = NULL,
+    updated_at = NOW()
+WHERE id = $1 AND status NOT IN ('accepted', 'voided', 'withdrawn')
+  AND (status != 'declined' OR rebid_allowed)
+RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount
 `
 
 type UpdateQuoteParams struct {
-	ID           uuid.UUID      `json:"id"`
-	Amount       pgtype.Numeric `json:"amount"`
-	ExpectedDays int32          `json:"expected_days"`
-	Note         pgtype.Text    `json:"note"`
+	ID                uuid.UUID          `json:"id"`
+	Amount            pgtype.Numeric     `json:"amount"`
+	ExpectedDays      int32              `json:"expected_days"`
+	Note              pgtype.Text        `json:"note"`
+	ValidUntil        pgtype.Timestamptz `json:"valid_until"`
+	PricingModel      string             `json:"pricing_model"`
+	HourlyRate        pgtype.Numeric     `json:"hourly_rate"`
+	EstimatedHoursMin pgtype.Numeric     `json:"estimated_hours_min"`
+	EstimatedHoursMax pgtype.Numeric     `json:"estimated_hours_max"`
+	CapAmount         pgtype.Numeric     `json:"cap_amount"`
+	DepositAmount     pgtype.Numeric     `json:"deposit_amount"`
 }
 
 func (q *Queries) UpdateQuote(ctx context.Context, arg *UpdateQuoteParams) (*Quote, error) {
@@ -361,6 +845,13 @@ func (q *Queries) UpdateQuote(ctx context.Context, arg *UpdateQuoteParams) (*Quo
 		arg.Amount,
 		arg.ExpectedDays,
 		arg.Note,
+		arg.ValidUntil,
+		arg.PricingModel,
+		arg.HourlyRate,
+		arg.EstimatedHoursMin,
+		arg.EstimatedHoursMax,
+		arg.CapAmount,
+		arg.DepositAmount,
 	)
 	var i Quote
 	err := row.Scan(
@@ -373,6 +864,88 @@ func (q *Queries) UpdateQuote(ctx context.Context, arg *UpdateQuoteParams) (*Quo
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ValidUntil,
+		&i.DeclineReason,
+		&i.RebidAllowed,
+		&i.AgreedAmount,
+		&i.AgreedDays,
+		&i.PricingModel,
+		&i.HourlyRate,
+		&i.EstimatedHoursMin,
+		&i.EstimatedHoursMax,
+		&i.CapAmount,
+		&i.DepositAmount,
+	)
+	return &i, err
+}
+
+const VoidQuote = `-- name: VoidQuote :one
+UPDATE quotes
+SET status = 'voided', updated_at = NOW()
+WHERE id = $1 AND status = 'proposed'
+RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount
+`
+
+func (q *Queries) VoidQuote(ctx context.Context, id uuid.UUID) (*Quote, error) {
+	row := q.db.QueryRow(ctx, VoidQuote, id)
+	var i Quote
+	err := row.Scan(
+		&i.ID,
+		&i.CaseID,
+		&i.LawyerID,
+		&i.Amount,
+		&i.ExpectedDays,
+		&i.Note,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ValidUntil,
+		&i.DeclineReason,
+		&i.RebidAllowed,
+		&i.AgreedAmount,
+		&i.AgreedDays,
+		&i.PricingModel,
+		&i.HourlyRate,
+		&i.EstimatedHoursMin,
+		&i.EstimatedHoursMax,
+		&i.CapAmount,
+		&i.DepositAmount,
+	)
+	return &i, err
+}
+
+const WithdrawQuote = `-- name: WithdrawQuote :one
+UPDATE quotes
+SET status = 'withdrawn', updated_at = NOW()
+WHERE id = $1 AND status = 'proposed'
+  AND NOT EXISTS (SELECT 1 FROM payments WHERE quote_id = $1 AND status = 'pending')
+RETURNING id, case_id, lawyer_id, amount, expected_days, note, status, created_at, updated_at, valid_until, decline_reason, rebid_allowed, agreed_amount, agreed_days, pricing_model, hourly_rate, estimated_hours_min, estimated_hours_max, cap_amount, deposit_amount
+`
+
+func (q *Queries) WithdrawQuote(ctx context.Context, id uuid.UUID) (*Quote, error) {
+	row := q.db.QueryRow(ctx, WithdrawQuote, id)
+	var i Quote
+	err := row.Scan(
+		&i.ID,
+		&i.CaseID,
+		&i.LawyerID,
+		&i.Amount,
+		&i.ExpectedDays,
+		&i.Note,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ValidUntil,
+		&i.DeclineReason,
+		&i.RebidAllowed,
+		&i.AgreedAmount,
+		&i.AgreedDays,
+		&i.PricingModel,
+		&i.HourlyRate,
+		&i.EstimatedHoursMin,
+		&i.EstimatedHoursMax,
+		&i.CapAmount,
+		&i.DepositAmount,
 	)
 	return &i, err
 }
@@ -12,10 +12,28 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const CountUsers = `-- name: CountUsers :one
+SELECT COUNT(*) FROM users
+WHERE ($1::VARCHAR IS NULL OR $1::VARCHAR = '' OR role = $1)
+  AND ($2::VARCHAR IS NULL OR $2::VARCHAR = '' OR email ILIKE '%' || $2 || '%' OR name ILIKE '%' || $2 || '%')
+`
+
+type CountUsersParams struct {
+	Column1 string `json:"column_1"`
+	Column2 string `json:"column_2"`
+}
+
+func (q *Queries) CountUsers(ctx context.Context, arg *CountUsersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, CountUsers, arg.Column1, arg.Column2)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const CreateUser = `-- name: CreateUser :one
 INSERT INTO users (email, password_hash, name, role, jurisdiction, bar_number)
 VALUES ($1, $2, $3, $4, $5, $6)
-RETURNING id, email, password_hash, name, role, jurisdiction, bar_number, created_at, updated_at
+RETURNING id, email, password_hash, name, role, jurisdiction, bar_number, created_at, updated_at, email_verified_at, suspended_at, suspension_reason
 `
 
 type CreateUserParams struct {
@@ -47,12 +65,15 @@ func (q *Queries) CreateUser(ctx context.Context, arg *CreateUserParams) (*User,
 		&i.BarNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
 	)
 	return &i, err
 }
 
 const GetUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, password_hash, name, role, jurisdiction, bar_number, created_at, updated_at FROM users WHERE email = $1
+SELECT id, email, password_hash, name, role, jurisdiction, bar_number, created_at, updated_at, email_verified_at, suspended_at, suspension_reason FROM users WHERE email = $1
 `
 
 func (q *Queries) GetUserByEmail(ctx context.Context, email string) (*User, error) {
@@ -68,12 +89,15 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (*User, erro
 		&i.BarNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
 	)
 	return &i, err
 }
 
 const GetUserByID = `-- name: GetUserByID :one
-SELECT id, email, password_hash, name, role, jurisdiction, bar_number, created_at, updated_at FROM users WHERE id = $1
+SELECT id, email, password_hash, name, role, jurisdiction, bar_number, created_at, updated_at, email_verified_at, suspended_at, suspension_reason FROM users WHERE id = $1
 `
 
 func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (*User, error) {
@@ -89,6 +113,148 @@ func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (*User, error)
 		&i.BarNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
+	)
+	return &i, err
+}
+
+const ListUsers = `-- name: ListUsers :many
+SELECT id, email, password_hash, name, role, jurisdiction, bar_number, created_at, updated_at, email_verified_at, suspended_at, suspension_reason FROM users
+WHERE ($1::VARCHAR IS NULL OR $1::VARCHAR = '' OR role = $1)
+  AND ($2::VARCHAR IS NULL OR $2::VARCHAR = '' OR email ILIKE '%' || $2 || '%' OR name ILIKE '%' || $2 || '%')
+ORDER BY created_at DESC
+LIMIT $3 OFFSET $4
+`
+
+type ListUsersParams struct {
+	Column1 string `json:"column_1"`
+	Column2 string `json:"column_2"`
+	Limit   int32  `json:"limit"`
+	Offset  int32  `json:"offset"`
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg *ListUsersParams) ([]*User, error) {
+	rows, err := q.db.Query(ctx, ListUsers,
+		arg.Column1,
+		arg.Column2,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.PasswordHash,
+			&i.Name,
+			&i.Role,
+			&i.Jurisdiction,
+			&i.BarNumber,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.EmailVerifiedAt,
+			&i.SuspendedAt,
+			&i.SuspensionReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const MarkUserEmailVerified = `-- name: MarkUserEmailVerified :one
+UPDATE users
+SET email_verified_at = COALESCE(email_verified_at, NOW()), updated_at = NOW()
+WHERE id = $1
+RETURNING id, email, password_hash, name, role, jurisdiction, bar_number, created_at, updated_at, email_verified_at, suspended_at, suspension_reason
+`
+
+func (q *Queries) MarkUserEmailVerified(ctx context.Context, id uuid.UUID) (*User, error) {
+	row := q.db.QueryRow(ctx, MarkUserEmailVerified, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Name,
+		&i.Role,
+		&i.Jurisdiction,
+		&i.BarNumber,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
+	)
+	return &i, err
+}
+
+const ReactivateUser = `-- name: ReactivateUser :one
+UPDATE users
+SET suspended_at = NULL, suspension_reason = NULL, updated_at = NOW()
+WHERE id = $1
+RETURNING id, email, password_hash, name, role, jurisdiction, bar_number, created_at, updated_at, email_verified_at, suspended_at, suspension_reason
+`
+
+func (q *Queries) ReactivateUser(ctx context.Context, id uuid.UUID) (*User, error) {
+	row := q.db.QueryRow(ctx, ReactivateUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Name,
+		&i.Role,
+		&i.Jurisdiction,
+		&i.BarNumber,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
+	)
+	return &i, err
+}
+
+const SuspendUser = `-- name: SuspendUser :one
+UPDATE users
+SET suspended_at = NOW(), suspension_reason = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING id, email, password_hash, name, role, jurisdiction, bar_number, created_at, updated_at, email_verified_at, suspended_at, suspension_reason
+`
+
+type SuspendUserParams struct {
+	ID               uuid.UUID   `json:"id"`
+	SuspensionReason pgtype.Text `json:"suspension_reason"`
+}
+
+func (q *Queries) SuspendUser(ctx context.Context, arg *SuspendUserParams) (*User, error) {
+	row := q.db.QueryRow(ctx, SuspendUser, arg.ID, arg.SuspensionReason)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Name,
+		&i.Role,
+		&i.Jurisdiction,
+		&i.BarNumber,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
 	)
 	return &i, err
 }
@@ -100,7 +266,7 @@ SET name = COALESCE($2, name),
     bar_number = COALESCE($4, bar_number),
     updated_at = NOW()
 WHERE id = $1
-RETURNING id, email, password_hash, name, role, jurisdiction, bar_number, created_at, updated_at
+RETURNING id, email, password_hash, name, role, jurisdiction, bar_number, created_at, updated_at, email_verified_at, suspended_at, suspension_reason
 `
 
 type UpdateUserParams struct {
@@ -128,6 +294,25 @@ func (q *Queries) UpdateUser(ctx context.Context, arg *UpdateUserParams) (*User,
 		&i.BarNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
 	)
 	return &i, err
 }
+
+const UpdateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = $2, updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateUserPasswordParams struct {
+	ID           uuid.UUID `json:"id"`
+	PasswordHash string    `json:"password_hash"`
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg *UpdateUserPasswordParams) error {
+	_, err := q.db.Exec(ctx, UpdateUserPassword, arg.ID, arg.PasswordHash)
+	return err
+}
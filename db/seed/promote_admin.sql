@@ -0,0 +1,7 @@
+-- Promotes an existing account to admin. There is no admin signup, so the
+-- first admin signs up as a client and is promoted with:
+--   psql "$DB_CONN_STRING" -v email=admin@example.com -f db/seed/promote_admin.sql
+UPDATE users
+SET role = 'admin', updated_at = NOW()
+WHERE email = :'email'
+RETURNING id, email, role;
@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 type SignupRequest struct {
 	Email       string `json:"email" binding:"required,email"`
 	Password    string `json:"password" binding:"required,min=8"`
@@ -14,27 +16,144 @@ type LoginRequest struct {
 	Password string `json:"password" binding:"required"`
 }
 
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required,min=8"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
 type CreateCaseRequest struct {
+	Title        string `json:"title" binding:"required"`
+	Category     string `json:"category" binding:"required"`
+	Description  string `json:"description" binding:"required"`
+	Jurisdiction string `json:"jurisdiction" binding:"required,max=100"`
+	// Deadline is optional and must be in the future (RFC 3339).
+	Deadline *time.Time `json:"deadline"`
+	// Redactions are parts of the description to hide from the marketplace
+	// in addition to what the detectors find.
+	Redactions []RedactionSpan `json:"redactions" binding:"dive"`
+}
+
+// RedactionSpan is a character range of a case description, end exclusive.
+type RedactionSpan struct {
+	Start int `json:"start" binding:"min=0"`
+	End   int `json:"end" binding:"gtfield=Start"`
+}
+
+type UpdateCaseRedactionsRequest struct {
+	Redactions []RedactionSpan `json:"redactions" binding:"dive"`
+}
+
+type UpdateCaseRequest struct {
 	Title       string `json:"title" binding:"required"`
 	Category    string `json:"category" binding:"required"`
 	Description string `json:"description" binding:"required"`
 }
 
 type SubmitQuoteRequest struct {
+	// PricingModel is "fixed" (the default), "hourly" or "capped". Amount is
+	// the fee of a fixed quote; hourly and capped quotes give an hourly rate,
+	// an estimated hours range and a deposit instead, and capped quotes a cap.
+	PricingModel      string `json:"pricing_model" binding:"omitempty,oneof=fixed hourly capped"`
+	Amount            string `json:"amount"`
+	HourlyRate        string `json:"hourly_rate"`
+	EstimatedHoursMin string `json:"estimated_hours_min"`
+	EstimatedHoursMax string `json:"estimated_hours_max"`
+	CapAmount         string `json:"cap_amount"`
+	DepositAmount     string `json:"deposit_amount"`
+	ExpectedDays      int    `json:"expected_days" binding:"required,min=1"`
+	Note              string `json:"note"`
+	// ValidUntil is optional and must be in the future (RFC 3339); without
+	// it the quote is valid for the configured number of days.
+	ValidUntil *time.Time `json:"valid_until"`
+	// Milestones optionally split the quote into stages paid one at a time;
+	// their amounts must add up to Amount.
+	Milestones []QuoteMilestoneRequest `json:"milestones" binding:"omitempty,max=20,dive"`
+}
+
+type QuoteMilestoneRequest struct {
+	Title   string    `json:"title" binding:"required,max=255"`
+	Amount  string    `json:"amount" binding:"required"`
+	DueDate time.Time `json:"due_date" binding:"required"`
+}
+
+// QuoteCompareFilters sorts and narrows the quote comparison. Sort is one of
+// amount, expected_days, price_per_day, rating, completed_cases, revisions or
+// created_at. Status defaults to proposed; "all" includes every status.
+type QuoteCompareFilters struct {
+	Sort      string  `form:"sort"`
+	Order     string  `form:"order" binding:"omitempty,oneof=asc desc"`
+	Status    string  `form:"status"`
+	MaxAmount string  `form:"max_amount"`
+	MaxDays   int     `form:"max_days" binding:"min=0"`
+	MinRating float64 `form:"min_rating" binding:"min=0,max=5"`
+}
+
+// QuoteOfferRequest is a counter-offer on a quote's amount and timeline.
+type QuoteOfferRequest struct {
 	Amount       string `json:"amount" binding:"required"`
 	ExpectedDays int    `json:"expected_days" binding:"required,min=1"`
-	Note         string `json:"note"`
+	Note         string `json:"note" binding:"max=1000"`
+}
+
+// TopUpRequest bills the client for hours worked on an hourly or capped quote.
+type TopUpRequest struct {
+	Hours string `json:"hours" binding:"required"`
+	Note  string `json:"note" binding:"max=1000"`
+}
+
+type DeclineQuoteRequest struct {
+	Reason string `json:"reason" binding:"max=1000"`
+}
+
+type ReviewCaseRequest struct {
+	Rating  int    `json:"rating" binding:"required,min=1,max=5"`
+	Comment string `json:"comment" binding:"max=2000"`
 }
 
 type AcceptQuoteRequest struct {
 	QuoteID string `json:"quote_id" binding:"required,uuid"`
 }
 
+type SubmitVerificationRequest struct {
+	Jurisdiction string `json:"jurisdiction" binding:"required"`
+	BarNumber    string `json:"bar_number" binding:"required"`
+}
+
+type SavedSearchRequest struct {
+	Name         string `json:"name" binding:"required,max=100"`
+	Category     string `json:"category"`
+	Query        string `json:"q"`
+	Jurisdiction string `json:"jurisdiction" binding:"max=100"`
+}
+
+type ReviewVerificationRequest struct {
+	Note string `json:"note"`
+}
+
 type MarketplaceFilters struct {
-	Category    string `form:"category"`
-	CreatedSince string `form:"created_since"`
-	Page        int    `form:"page"`
-	PageSize    int    `form:"page_size"`
+	Category      string `form:"category"`
+	Query         string `form:"q"`
+	Jurisdiction  string `form:"jurisdiction"`
+	Sort          string `form:"sort"`
+	NotQuotedByMe bool   `form:"not_quoted_by_me"`
+	MinQuotes     *int   `form:"min_quotes"`
+	MaxQuotes     *int   `form:"max_quotes"`
+	Cursor        string `form:"cursor"`
+	CreatedSince  string `form:"created_since"`
+	Page          int    `form:"page"`
+	PageSize      int    `form:"page_size"`
 }
 
 type MyQuotesFilters struct {
@@ -42,3 +161,29 @@ type MyQuotesFilters struct {
 	Page     int    `form:"page"`
 	PageSize int    `form:"page_size"`
 }
+
+type AdminActionRequest struct {
+	Reason string `json:"reason"`
+}
+
+type SuspendUserRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+type AdminUserFilters struct {
+	Role     string `form:"role"`
+	Query    string `form:"q"`
+	Page     int    `form:"page"`
+	PageSize int    `form:"page_size"`
+}
+
+type UpdateProfileRequest struct {
+	Name         *string `json:"name"`
+	Jurisdiction *string `json:"jurisdiction"`
+	BarNumber    *string `json:"bar_number"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required,min=8"`
+}
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,47 +13,113 @@ type MessageResponse struct {
 }
 
 type AuthResponse struct {
-	Token string       `json:"token"`
-	User  UserResponse `json:"user"`
+	Token        string       `json:"token"`
+	RefreshToken string       `json:"refresh_token"`
+	ExpiresAt    time.Time    `json:"expires_at"`
+	User         UserResponse `json:"user"`
 }
 
 type UserResponse struct {
-	ID           uuid.UUID `json:"id"`
-	Email        string    `json:"email"`
-	Name         string    `json:"name"`
-	Role         string    `json:"role"`
-	Jurisdiction *string   `json:"jurisdiction,omitempty"`
-	BarNumber    *string   `json:"bar_number,omitempty"`
-	CreatedAt    time.Time `json:"created_at"`
+	ID               uuid.UUID  `json:"id"`
+	Email            string     `json:"email"`
+	Name             string     `json:"name"`
+	Role             string     `json:"role"`
+	Jurisdiction     *string    `json:"jurisdiction,omitempty"`
+	BarNumber        *string    `json:"bar_number,omitempty"`
+	EmailVerified    bool       `json:"email_verified"`
+	SuspendedAt      *time.Time `json:"suspended_at,omitempty"`
+	SuspensionReason *string    `json:"suspension_reason,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
 }
 
 type CaseResponse struct {
-	ID          uuid.UUID `json:"id"`
-	ClientID    uuid.UUID `json:"client_id"`
-	Title       string    `json:"title"`
-	Category    string    `json:"category"`
-	Description string    `json:"description"`
-	Status      string    `json:"status"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID           uuid.UUID  `json:"id"`
+	ClientID     uuid.UUID  `json:"client_id"`
+	Title        string     `json:"title"`
+	Category     string     `json:"category"`
+	Description  string     `json:"description"`
+	Jurisdiction *string    `json:"jurisdiction,omitempty"`
+	Deadline     *time.Time `json:"deadline,omitempty"`
+	Status       string     `json:"status"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
 }
 
 type CaseWithQuotesResponse struct {
 	CaseResponse
-	QuotesCount int             `json:"quotes_count"`
-	Quotes      []QuoteResponse `json:"quotes,omitempty"`
-	Files       []FileResponse  `json:"files,omitempty"`
+	QuotesCount int                        `json:"quotes_count"`
+	Quotes      []QuoteResponse            `json:"quotes,omitempty"`
+	Files       []FileResponse             `json:"files,omitempty"`
+	Timeline    []CaseStatusChangeResponse `json:"timeline,omitempty"`
+	Revisions   []CaseRevisionResponse     `json:"revisions,omitempty"`
+	// Redactions lists what the marketplace hides from lawyers; only the
+	// owning client sees it.
+	Redactions []CaseRedactionResponse `json:"redactions,omitempty"`
+}
+
+// CaseRedactionResponse is one span of the description hidden from the
+// marketplace, in characters of the description.
+type CaseRedactionResponse struct {
+	Detector string `json:"detector"`
+	Start    int    `json:"start"`
+	End      int    `json:"end"`
+	Text     string `json:"text"`
+}
+
+// MarketplacePreviewResponse shows a client their case as lawyers see it in
+// the marketplace, with what was hidden.
+type MarketplacePreviewResponse struct {
+	MarketplaceCaseResponse
+	Redactions []CaseRedactionResponse `json:"redactions"`
+}
+
+// CaseRevisionResponse is the content of a case before an edit, together
+// with the fields that edit changed.
+type CaseRevisionResponse struct {
+	Revision      int       `json:"revision"`
+	Title         string    `json:"title"`
+	Category      string    `json:"category"`
+	Description   string    `json:"description"`
+	ChangedFields []string  `json:"changed_fields"`
+	EditedAt      time.Time `json:"edited_at"`
+}
+
+type CaseStatusChangeResponse struct {
+	FromStatus *string    `json:"from_status,omitempty"`
+	ToStatus   string     `json:"to_status"`
+	ActorID    *uuid.UUID `json:"actor_id,omitempty"`
+	ActorRole  string     `json:"actor_role"`
+	ActorName  *string    `json:"actor_name,omitempty"`
+	Reason     *string    `json:"reason,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
 }
 
 type MarketplaceCaseResponse struct {
-	ID           uuid.UUID      `json:"id"`
-	Title        string         `json:"title"`
-	Category     string         `json:"category"`
-	Description  string         `json:"description"`
+	ID           uuid.UUID  `json:"id"`
+	Title        string     `json:"title"`
+	Category     string     `json:"category"`
+	Description  string     `json:"description"`
+	Jurisdiction *string    `json:"jurisdiction,omitempty"`
+	Deadline     *time.Time `json:"deadline,omitempty"`
+	// QuotesCount is the number of proposed quotes; only set in listings.
+	QuotesCount  *int           `json:"quotes_count,omitempty"`
 	CreatedAt    time.Time      `json:"created_at"`
 	Status       string         `json:"status,omitempty"`
 	Files        []FileResponse `json:"files,omitempty"`
 	HasSubmitted bool           `json:"has_submitted"`
+	// Snippet and Relevance are only set when searching with q.
+	Snippet   *string `json:"snippet,omitempty"`
+	Relevance float32 `json:"relevance,omitempty"`
+	// Revisions and ChangedSinceMyQuote are only set for lawyers who have
+	// quoted on the case.
+	Revisions           []CaseRevisionResponse `json:"revisions,omitempty"`
+	ChangedSinceMyQuote bool                   `json:"changed_since_my_quote,omitempty"`
+}
+
+type CategoryResponse struct {
+	Slug       string  `json:"slug"`
+	Name       string  `json:"name"`
+	ParentSlug *string `json:"parent_slug,omitempty"`
 }
 
 type QuoteResponse struct {
@@ -65,8 +132,134 @@ type QuoteResponse struct {
 	Status       string          `json:"status"`
 	CreatedAt    time.Time       `json:"created_at"`
 	UpdatedAt    time.Time       `json:"updated_at"`
-	LawyerName   *string         `json:"lawyer_name,omitempty"`
-	CaseTitle    *string         `json:"case_title,omitempty"`
+	ValidUntil   *time.Time      `json:"valid_until,omitempty"`
+	// DeclineReason is the client's reason for declining; RebidAllowed means
+	// the client reopened bidding for the lawyer.
+	DeclineReason *string `json:"decline_reason,omitempty"`
+	RebidAllowed  bool    `json:"rebid_allowed,omitempty"`
+	// AgreedAmount and AgreedDays are the terms of the last accepted
+	// counter-offer; accepting the quote charges AgreedAmount.
+	AgreedAmount *decimal.Decimal      `json:"agreed_amount,omitempty"`
+	AgreedDays   *int                  `json:"agreed_days,omitempty"`
+	Pricing      *QuotePricingResponse `json:"pricing,omitempty"`
+	// Milestones are the stages of a milestone-based quote, in order.
+	Milestones []QuoteMilestoneResponse `json:"milestones,omitempty"`
+	LawyerName *string                  `json:"lawyer_name,omitempty"`
+	CaseTitle  *string                  `json:"case_title,omitempty"`
+	// Revisions and ChangedSinceViewed are only set on case details.
+	// ChangedSinceViewed means the lawyer revised the quote after the
+	// viewer last opened the case.
+	Revisions          []QuoteRevisionResponse `json:"revisions,omitempty"`
+	ChangedSinceViewed bool                    `json:"changed_since_viewed,omitempty"`
+}
+
+// QuoteRevisionResponse is the terms of a quote before a lawyer's edit.
+type QuoteRevisionResponse struct {
+	Revision     int                   `json:"revision"`
+	Amount       decimal.Decimal       `json:"amount"`
+	ExpectedDays int                   `json:"expected_days"`
+	Note         string                `json:"note"`
+	Pricing      *QuotePricingResponse `json:"pricing,omitempty"`
+	// Milestones are unset for revisions saved before milestones were kept.
+	Milestones []QuoteRevisionMilestoneResponse `json:"milestones,omitempty"`
+	RevisedAt  time.Time                        `json:"revised_at"`
+}
+
+// QuoteRevisionMilestoneResponse is one stage of a quote before a lawyer's
+// edit.
+type QuoteRevisionMilestoneResponse struct {
+	Position int             `json:"position"`
+	Title    string          `json:"title"`
+	Amount   decimal.Decimal `json:"amount"`
+	DueDate  time.Time       `json:"due_date"`
+}
+
+// QuotePricingResponse is how a quote is priced, with totals ready to display.
+// For fixed quotes both estimated totals are the fee.
+type QuotePricingResponse struct {
+	Model             string           `json:"model"`
+	HourlyRate        *decimal.Decimal `json:"hourly_rate,omitempty"`
+	EstimatedHoursMin *decimal.Decimal `json:"estimated_hours_min,omitempty"`
+	EstimatedHoursMax *decimal.Decimal `json:"estimated_hours_max,omitempty"`
+	CapAmount         *decimal.Decimal `json:"cap_amount,omitempty"`
+	DepositAmount     *decimal.Decimal `json:"deposit_amount,omitempty"`
+	EstimatedTotalMin decimal.Decimal  `json:"estimated_total_min"`
+	EstimatedTotalMax decimal.Decimal  `json:"estimated_total_max"`
+	Summary           string           `json:"summary"`
+}
+
+// TopUpResponse is a top-up billed to the client and its payment link.
+type TopUpResponse struct {
+	Hours   decimal.Decimal       `json:"hours"`
+	Amount  decimal.Decimal       `json:"amount"`
+	Payment PaymentIntentResponse `json:"payment"`
+}
+
+// QuoteMilestoneResponse is one stage of a quote. Status moves from pending to
+// paid, delivered by the lawyer and approved by the client.
+type QuoteMilestoneResponse struct {
+	ID          uuid.UUID       `json:"id"`
+	Position    int             `json:"position"`
+	Title       string          `json:"title"`
+	Amount      decimal.Decimal `json:"amount"`
+	DueDate     time.Time       `json:"due_date"`
+	Status      string          `json:"status"`
+	PaidAt      *time.Time      `json:"paid_at,omitempty"`
+	DeliveredAt *time.Time      `json:"delivered_at,omitempty"`
+	ApprovedAt  *time.Time      `json:"approved_at,omitempty"`
+}
+
+// MilestoneApprovalResponse is an approved milestone and, when another stage
+// follows, the payment link for it. NextPaymentError says why that link could
+// not be created; the approval stands either way.
+type MilestoneApprovalResponse struct {
+	Milestone        QuoteMilestoneResponse `json:"milestone"`
+	NextPayment      *PaymentIntentResponse `json:"next_payment,omitempty"`
+	NextPaymentError *string                `json:"next_payment_error,omitempty"`
+}
+
+// QuoteOfferResponse is one step of the negotiation on a quote. ProposerRole is
+// "client" or "lawyer".
+type QuoteOfferResponse struct {
+	ID           uuid.UUID       `json:"id"`
+	QuoteID      uuid.UUID       `json:"quote_id"`
+	ProposerRole string          `json:"proposer_role"`
+	Amount       decimal.Decimal `json:"amount"`
+	ExpectedDays int             `json:"expected_days"`
+	Note         string          `json:"note"`
+	Status       string          `json:"status"`
+	CreatedAt    time.Time       `json:"created_at"`
+	RespondedAt  *time.Time      `json:"responded_at,omitempty"`
+}
+
+// QuoteComparisonResponse is a quote with the metrics clients compare quotes
+// on. AverageRating is nil until the lawyer has been reviewed.
+type QuoteComparisonResponse struct {
+	QuoteID        uuid.UUID             `json:"quote_id"`
+	LawyerID       uuid.UUID             `json:"lawyer_id"`
+	LawyerName     *string               `json:"lawyer_name,omitempty"`
+	Status         string                `json:"status"`
+	Amount         decimal.Decimal       `json:"amount"`
+	ExpectedDays   int                   `json:"expected_days"`
+	PricePerDay    decimal.Decimal       `json:"price_per_day"`
+	Pricing        *QuotePricingResponse `json:"pricing"`
+	Note           string                `json:"note"`
+	ValidUntil     *time.Time            `json:"valid_until,omitempty"`
+	CompletedCases int                   `json:"completed_cases"`
+	ReviewCount    int                   `json:"review_count"`
+	AverageRating  *float64              `json:"average_rating"`
+	RevisionCount  int                   `json:"revision_count"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+type CaseReviewResponse struct {
+	ID        uuid.UUID `json:"id"`
+	CaseID    uuid.UUID `json:"case_id"`
+	LawyerID  uuid.UUID `json:"lawyer_id"`
+	Rating    int       `json:"rating"`
+	Comment   *string   `json:"comment,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type FileResponse struct {
@@ -78,12 +271,58 @@ type FileResponse struct {
 	DownloadURL *string   `json:"download_url,omitempty"`
 }
 
+type LawyerVerificationResponse struct {
+	ID           uuid.UUID      `json:"id"`
+	LawyerID     uuid.UUID      `json:"lawyer_id"`
+	LawyerName   *string        `json:"lawyer_name,omitempty"`
+	LawyerEmail  *string        `json:"lawyer_email,omitempty"`
+	Jurisdiction *string        `json:"jurisdiction,omitempty"`
+	BarNumber    *string        `json:"bar_number,omitempty"`
+	Status       string         `json:"status"`
+	ReviewNote   *string        `json:"review_note,omitempty"`
+	ReviewedAt   *time.Time     `json:"reviewed_at,omitempty"`
+	Documents    []FileResponse `json:"documents,omitempty"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+type AdminActionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	AdminID    uuid.UUID `json:"admin_id"`
+	AdminName  *string   `json:"admin_name,omitempty"`
+	AdminEmail string    `json:"admin_email"`
+	Action     string    `json:"action"`
+	TargetType string    `json:"target_type"`
+	TargetID   uuid.UUID `json:"target_id"`
+	Reason     *string   `json:"reason,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type SavedSearchResponse struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	Category     *string   `json:"category,omitempty"`
+	Query        *string   `json:"q,omitempty"`
+	Jurisdiction *string   `json:"jurisdiction,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type NotificationResponse struct {
+	ID        uuid.UUID       `json:"id"`
+	Type      string          `json:"type"`
+	Data      json.RawMessage `json:"data"`
+	ReadAt    *time.Time      `json:"read_at,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
 type PaginatedResponse struct {
 	Data       interface{} `json:"data"`
 	Page       int         `json:"page"`
 	PageSize   int         `json:"page_size"`
 	Total      int64       `json:"total"`
 	TotalPages int         `json:"total_pages"`
+	// NextCursor is set when more results follow; pass it back as ?cursor=.
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 type PaymentIntentResponse struct {
@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type AdminHandler struct {
+	adminService *service.AdminService
+}
+
+func NewAdminHandler(adminService *service.AdminService) *AdminHandler {
+	return &AdminHandler{
+		adminService: adminService,
+	}
+}
+
+func (h *AdminHandler) ListUsers(c *gin.Context) {
+	var filters dto.AdminUserFilters
+	if err := c.ShouldBindQuery(&filters); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if filters.Page < 1 {
+		filters.Page = 1
+	}
+	if filters.PageSize < 1 {
+		filters.PageSize = 10
+	}
+
+	users, total, err := h.adminService.ListUsers(c.Request.Context(), filters)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, paginated(users, filters.Page, filters.PageSize, total))
+}
+
+func (h *AdminHandler) SuspendUser(c *gin.Context) {
+	adminID, targetID, ok := h.parseAdminAndTarget(c, "invalid user ID")
+	if !ok {
+		return
+	}
+
+	var req dto.SuspendUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.adminService.SuspendUser(c.Request.Context(), adminID, targetID, req.Reason)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminHandler) ReactivateUser(c *gin.Context) {
+	adminID, targetID, ok := h.parseAdminAndTarget(c, "invalid user ID")
+	if !ok {
+		return
+	}
+
+	req, ok := bindAdminActionRequest(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.adminService.ReactivateUser(c.Request.Context(), adminID, targetID, req.Reason)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminHandler) CloseCase(c *gin.Context) {
+	adminID, targetID, ok := h.parseAdminAndTarget(c, "invalid case ID")
+	if !ok {
+		return
+	}
+
+	req, ok := bindAdminActionRequest(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.adminService.CloseCase(c.Request.Context(), adminID, targetID, req.Reason)
+	if err != nil {
+		c.JSON(caseErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminHandler) CancelCase(c *gin.Context) {
+	adminID, targetID, ok := h.parseAdminAndTarget(c, "invalid case ID")
+	if !ok {
+		return
+	}
+
+	req, ok := bindAdminActionRequest(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.adminService.CancelCase(c.Request.Context(), adminID, targetID, req.Reason)
+	if err != nil {
+		c.JSON(caseErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminHandler) VoidQuote(c *gin.Context) {
+	adminID, targetID, ok := h.parseAdminAndTarget(c, "invalid quote ID")
+	if !ok {
+		return
+	}
+
+	req, ok := bindAdminActionRequest(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.adminService.VoidQuote(c.Request.Context(), adminID, targetID, req.Reason)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *AdminHandler) ListActions(c *gin.Context) {
+	targetType := c.Query("target_type")
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	actions, total, err := h.adminService.ListActions(c.Request.Context(), targetType, page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, paginated(actions, page, pageSize, total))
+}
+
+func (h *AdminHandler) parseAdminAndTarget(c *gin.Context, invalidTargetMsg string) (uuid.UUID, uuid.UUID, bool) {
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	adminID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	targetID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": invalidTargetMsg})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return adminID, targetID, true
+}
+
+// bindAdminActionRequest reads the optional reason body; an empty body is
+// accepted.
+func bindAdminActionRequest(c *gin.Context) (dto.AdminActionRequest, bool) {
+	var req dto.AdminActionRequest
+	if c.Request.ContentLength == 0 {
+		return req, true
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return req, false
+	}
+	return req, true
+}
@@ -1,10 +1,13 @@
 package handler
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/lifecycle"
 	"github.com/gadhittana01/cases-app-server/service"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -57,25 +60,15 @@ func (h *CaseHandler) GetMyCases(c *gin.Context) {
 
 	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
 	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	cursor := c.Query("cursor")
 
-	cases, total, err := h.caseService.GetCasesByClientID(c.Request.Context(), clientID, page, pageSize)
+	cases, total, nextCursor, err := h.caseService.GetCasesByClientID(c.Request.Context(), clientID, page, pageSize, cursor)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	totalPages := int(total) / pageSize
-	if int(total)%pageSize > 0 {
-		totalPages++
-	}
-
-	c.JSON(http.StatusOK, dto.PaginatedResponse{
-		Data:       cases,
-		Page:       page,
-		PageSize:   pageSize,
-		Total:      total,
-		TotalPages: totalPages,
-	})
+	c.JSON(http.StatusOK, keysetPaginated(cases, cursor, page, pageSize, total, nextCursor))
 }
 
 func (h *CaseHandler) GetCaseByID(c *gin.Context) {
@@ -106,6 +99,124 @@ func (h *CaseHandler) GetCaseByID(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+func (h *CaseHandler) UpdateCase(c *gin.Context) {
+	caseIDStr := c.Param("id")
+	caseID, err := uuid.Parse(caseIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid case ID"})
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	clientID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	var req dto.UpdateCaseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.caseService.UpdateCase(c.Request.Context(), caseID, clientID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CaseHandler) GetMarketplacePreview(c *gin.Context) {
+	caseIDStr := c.Param("id")
+	caseID, err := uuid.Parse(caseIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid case ID"})
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	clientID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	response, err := h.caseService.GetMarketplacePreview(c.Request.Context(), caseID, clientID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CaseHandler) UpdateRedactions(c *gin.Context) {
+	caseIDStr := c.Param("id")
+	caseID, err := uuid.Parse(caseIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid case ID"})
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	clientID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	var req dto.UpdateCaseRedactionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.caseService.UpdateRedactions(c.Request.Context(), caseID, clientID, req.Redactions)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *CaseHandler) ReviewCase(c *gin.Context) {
+	caseIDStr := c.Param("id")
+	caseID, err := uuid.Parse(caseIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid case ID"})
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	clientID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	var req dto.ReviewCaseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.caseService.ReviewCase(c.Request.Context(), caseID, clientID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
 func (h *CaseHandler) UploadFile(c *gin.Context) {
 	caseIDStr := c.Param("id")
 	caseID, err := uuid.Parse(caseIDStr)
@@ -128,3 +239,46 @@ func (h *CaseHandler) UploadFile(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+func (h *CaseHandler) CancelCase(c *gin.Context) {
+	h.endCase(c, h.caseService.CancelCase)
+}
+
+func (h *CaseHandler) CloseCase(c *gin.Context) {
+	h.endCase(c, h.caseService.CloseCase)
+}
+
+func (h *CaseHandler) endCase(c *gin.Context, action func(ctx context.Context, caseID, clientID uuid.UUID) (*dto.CaseResponse, error)) {
+	caseIDStr := c.Param("id")
+	caseID, err := uuid.Parse(caseIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid case ID"})
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	clientID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	response, err := action(c.Request.Context(), caseID, clientID)
+	if err != nil {
+		c.JSON(caseErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// caseErrorStatus maps a rejected case status transition to 409 Conflict and
+// anything else to 400.
+func caseErrorStatus(err error) int {
+	var transitionErr *lifecycle.TransitionError
+	if errors.As(err, &transitionErr) {
+		return http.StatusConflict
+	}
+	return http.StatusBadRequest
+}
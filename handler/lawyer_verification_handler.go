@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/service"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type LawyerVerificationHandler struct {
+	verificationService *service.LawyerVerificationService
+}
+
+func NewLawyerVerificationHandler(verificationService *service.LawyerVerificationService) *LawyerVerificationHandler {
+	return &LawyerVerificationHandler{
+		verificationService: verificationService,
+	}
+}
+
+func (h *LawyerVerificationHandler) GetMyVerification(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	lawyerID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	response, err := h.verificationService.GetMyVerification(c.Request.Context(), lawyerID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *LawyerVerificationHandler) SubmitVerification(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	lawyerID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	var req dto.SubmitVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.verificationService.SubmitVerification(c.Request.Context(), lawyerID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *LawyerVerificationHandler) UploadDocument(c *gin.Context) {
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	lawyerID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		return
+	}
+
+	response, err := h.verificationService.UploadDocument(c.Request.Context(), lawyerID, file)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *LawyerVerificationHandler) ListVerifications(c *gin.Context) {
+	status := c.Query("status")
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+
+	verifications, total, err := h.verificationService.ListVerifications(c.Request.Context(), status, page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	totalPages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		totalPages++
+	}
+
+	c.JSON(http.StatusOK, dto.PaginatedResponse{
+		Data:       verifications,
+		Page:       page,
+		PageSize:   pageSize,
+		Total:      total,
+		TotalPages: totalPages,
+	})
+}
+
+func (h *LawyerVerificationHandler) GetVerification(c *gin.Context) {
+	verificationIDStr := c.Param("id")
+	verificationID, err := uuid.Parse(verificationIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid verification ID"})
+		return
+	}
+
+	response, err := h.verificationService.GetVerification(c.Request.Context(), verificationID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *LawyerVerificationHandler) ApproveVerification(c *gin.Context) {
+	h.reviewVerification(c, service.VerificationStatusApproved)
+}
+
+func (h *LawyerVerificationHandler) RejectVerification(c *gin.Context) {
+	h.reviewVerification(c, service.VerificationStatusRejected)
+}
+
+func (h *LawyerVerificationHandler) reviewVerification(c *gin.Context, status string) {
+	verificationIDStr := c.Param("id")
+	verificationID, err := uuid.Parse(verificationIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid verification ID"})
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	adminID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	var req dto.ReviewVerificationRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.verificationService.ReviewVerification(c.Request.Context(), verificationID, adminID, status, req.Note)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -24,27 +24,36 @@ func (h *MarketplaceHandler) ListOpenCases(c *gin.Context) {
 	var filters dto.MarketplaceFilters
 	filters.Category = c.Query("category")
 	filters.CreatedSince = c.Query("created_since")
+	filters.Cursor = c.Query("cursor")
+	filters.Query = c.Query("q")
+	filters.Jurisdiction = c.Query("jurisdiction")
+	filters.Sort = c.Query("sort")
+	filters.NotQuotedByMe = c.Query("not_quoted_by_me") == "true"
+	var ok bool
+	if filters.MinQuotes, ok = optionalCountQuery(c, "min_quotes"); !ok {
+		return
+	}
+	if filters.MaxQuotes, ok = optionalCountQuery(c, "max_quotes"); !ok {
+		return
+	}
 	filters.Page, _ = strconv.Atoi(c.DefaultQuery("page", "1"))
 	filters.PageSize, _ = strconv.Atoi(c.DefaultQuery("page_size", "10"))
 
-	cases, total, err := h.marketplaceService.ListOpenCases(c.Request.Context(), filters)
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	lawyerID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
 		return
 	}
 
-	totalPages := int(total) / filters.PageSize
-	if int(total)%filters.PageSize > 0 {
-		totalPages++
+	cases, total, nextCursor, err := h.marketplaceService.ListOpenCases(c.Request.Context(), lawyerID, filters)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
 	}
 
-	c.JSON(http.StatusOK, dto.PaginatedResponse{
-		Data:       cases,
-		Page:       filters.Page,
-		PageSize:   filters.PageSize,
-		Total:      total,
-		TotalPages: totalPages,
-	})
+	c.JSON(http.StatusOK, keysetPaginated(cases, filters.Cursor, filters.Page, filters.PageSize, total, nextCursor))
 }
 
 func (h *MarketplaceHandler) GetCaseForMarketplace(c *gin.Context) {
@@ -72,3 +81,19 @@ func (h *MarketplaceHandler) GetCaseForMarketplace(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+// optionalCountQuery parses a non-negative integer query parameter, returning
+// nil when it is absent. It writes the error response when the value is invalid.
+func optionalCountQuery(c *gin.Context, name string) (*int, bool) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, true
+	}
+
+	count, err := strconv.Atoi(value)
+	if err != nil || count < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid " + name})
+		return nil, false
+	}
+	return &count, true
+}
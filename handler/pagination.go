@@ -0,0 +1,38 @@
+package handler
+
+import "github.com/gadhittana01/cases-app-server/dto"
+
+func paginated(data interface{}, page, pageSize int, total int64) dto.PaginatedResponse {
+	totalPages := 0
+	if pageSize > 0 {
+		totalPages = int(total) / pageSize
+		if int(total)%pageSize > 0 {
+			totalPages++
+		}
+	}
+
+	return dto.PaginatedResponse{
+		Data:       data,
+		Page:       page,
+		PageSize:   pageSize,
+		Total:      total,
+		TotalPages: totalPages,
+	}
+}
+
+// keysetPaginated builds the response for a list endpoint that supports both
+// page/page_size and cursor requests. Cursor requests are not counted, so
+// they carry no page or total.
+func keysetPaginated(data interface{}, cursor string, page, pageSize int, total int64, nextCursor string) dto.PaginatedResponse {
+	if cursor != "" {
+		return dto.PaginatedResponse{
+			Data:       data,
+			PageSize:   pageSize,
+			NextCursor: nextCursor,
+		}
+	}
+
+	response := paginated(data, page, pageSize, total)
+	response.NextCursor = nextCursor
+	return response
+}
@@ -48,3 +48,92 @@ func (h *PaymentHandler) AcceptQuote(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+func (h *PaymentHandler) ApproveMilestone(c *gin.Context) {
+	quoteID, milestoneID, clientID, ok := parseMilestoneParams(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.paymentService.ApproveMilestone(c.Request.Context(), quoteID, milestoneID, clientID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *PaymentHandler) PayMilestone(c *gin.Context) {
+	quoteID, milestoneID, clientID, ok := parseMilestoneParams(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.paymentService.PayMilestone(c.Request.Context(), quoteID, milestoneID, clientID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *PaymentHandler) RequestTopUp(c *gin.Context) {
+	quoteIDStr := c.Param("id")
+	quoteID, err := uuid.Parse(quoteIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid quote ID"})
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	lawyerID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	var req dto.TopUpRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.paymentService.RequestTopUp(c.Request.Context(), quoteID, lawyerID, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// parseMilestoneParams reads the quote and milestone IDs and the caller. It
+// writes the error response when any of them is invalid.
+func parseMilestoneParams(c *gin.Context) (uuid.UUID, uuid.UUID, uuid.UUID, bool) {
+	quoteIDStr := c.Param("id")
+	quoteID, err := uuid.Parse(quoteIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid quote ID"})
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+
+	milestoneIDStr := c.Param("milestone_id")
+	milestoneID, err := uuid.Parse(milestoneIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid milestone ID"})
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	userUUID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+
+	return quoteID, milestoneID, userUUID, true
+}
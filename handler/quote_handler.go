@@ -82,6 +82,226 @@ func (h *QuoteHandler) UpdateQuote(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+func (h *QuoteHandler) WithdrawQuote(c *gin.Context) {
+	caseIDStr := c.Param("id")
+	caseID, err := uuid.Parse(caseIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid case ID"})
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	lawyerID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	response, err := h.quoteService.WithdrawQuote(c.Request.Context(), caseID, lawyerID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *QuoteHandler) DeclineQuote(c *gin.Context) {
+	quoteIDStr := c.Param("id")
+	quoteID, err := uuid.Parse(quoteIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid quote ID"})
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	clientID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	// The reason is optional, so an empty body is accepted.
+	var req dto.DeclineQuoteRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	response, err := h.quoteService.DeclineQuote(c.Request.Context(), quoteID, clientID, req.Reason)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *QuoteHandler) ReopenBidding(c *gin.Context) {
+	quoteIDStr := c.Param("id")
+	quoteID, err := uuid.Parse(quoteIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid quote ID"})
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	clientID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	response, err := h.quoteService.ReopenBidding(c.Request.Context(), quoteID, clientID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *QuoteHandler) GetQuoteOffers(c *gin.Context) {
+	quoteID, userUUID, role, ok := parseNegotiationParams(c)
+	if !ok {
+		return
+	}
+
+	offers, err := h.quoteService.GetQuoteOffers(c.Request.Context(), quoteID, userUUID, role)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, offers)
+}
+
+func (h *QuoteHandler) ProposeOffer(c *gin.Context) {
+	quoteID, userUUID, role, ok := parseNegotiationParams(c)
+	if !ok {
+		return
+	}
+
+	var req dto.QuoteOfferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response, err := h.quoteService.ProposeOffer(c.Request.Context(), quoteID, userUUID, role, req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+func (h *QuoteHandler) AcceptOffer(c *gin.Context) {
+	h.respondToOffer(c, true)
+}
+
+func (h *QuoteHandler) DeclineOffer(c *gin.Context) {
+	h.respondToOffer(c, false)
+}
+
+func (h *QuoteHandler) respondToOffer(c *gin.Context, accept bool) {
+	quoteID, userUUID, role, ok := parseNegotiationParams(c)
+	if !ok {
+		return
+	}
+
+	offerIDStr := c.Param("offer_id")
+	offerID, err := uuid.Parse(offerIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid offer ID"})
+		return
+	}
+
+	response, err := h.quoteService.RespondToOffer(c.Request.Context(), quoteID, offerID, userUUID, role, accept)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// parseNegotiationParams reads the quote ID and the caller, who may be the
+// client or the lawyer. It writes the error response when either is invalid.
+func parseNegotiationParams(c *gin.Context) (uuid.UUID, uuid.UUID, string, bool) {
+	quoteIDStr := c.Param("id")
+	quoteID, err := uuid.Parse(quoteIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid quote ID"})
+		return uuid.Nil, uuid.Nil, "", false
+	}
+
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	userUUID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return uuid.Nil, uuid.Nil, "", false
+	}
+
+	role, _ := c.Get("role")
+	roleStr := role.(string)
+
+	return quoteID, userUUID, roleStr, true
+}
+
+func (h *QuoteHandler) DeliverMilestone(c *gin.Context) {
+	quoteID, milestoneID, lawyerID, ok := parseMilestoneParams(c)
+	if !ok {
+		return
+	}
+
+	response, err := h.quoteService.DeliverMilestone(c.Request.Context(), quoteID, milestoneID, lawyerID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *QuoteHandler) CompareQuotes(c *gin.Context) {
+	caseIDStr := c.Param("id")
+	caseID, err := uuid.Parse(caseIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid case ID"})
+		return
+	}
+
+	userID, _ := c.Get("user_id")
+	userIDStr := userID.(string)
+	clientID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid user ID"})
+		return
+	}
+
+	var filters dto.QuoteCompareFilters
+	if err := c.ShouldBindQuery(&filters); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	quotes, err := h.quoteService.CompareQuotes(c.Request.Context(), caseID, clientID, filters)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, quotes)
+}
+
 func (h *QuoteHandler) GetMyQuoteForCase(c *gin.Context) {
 	caseIDStr := c.Param("id")
 	caseID, err := uuid.Parse(caseIDStr)
@@ -130,23 +350,13 @@ func (h *QuoteHandler) GetMyQuotes(c *gin.Context) {
 		pageSizeStr = c.DefaultQuery("page_size", "10")
 	}
 	pageSize, _ := strconv.Atoi(pageSizeStr)
+	cursor := c.Query("cursor")
 
-	quotes, total, err := h.quoteService.GetQuotesByLawyerID(c.Request.Context(), lawyerID, status, page, pageSize)
+	quotes, total, nextCursor, err := h.quoteService.GetQuotesByLawyerID(c.Request.Context(), lawyerID, status, page, pageSize, cursor)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	totalPages := int(total) / pageSize
-	if int(total)%pageSize > 0 {
-		totalPages++
-	}
-
-	c.JSON(http.StatusOK, dto.PaginatedResponse{
-		Data:       quotes,
-		Page:       page,
-		PageSize:   pageSize,
-		Total:      total,
-		TotalPages: totalPages,
-	})
+	c.JSON(http.StatusOK, keysetPaginated(quotes, cursor, page, pageSize, total, nextCursor))
 }
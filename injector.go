@@ -1,16 +1,26 @@
 package main
 
 import (
+	"context"
+
+	"github.com/gadhittana01/cases-app-server/service"
 	"github.com/gadhittana01/cases-modules/utils"
 	"github.com/gin-gonic/gin"
 )
 
 type App struct {
-	router *gin.Engine
-	config *utils.Config
+	router             *gin.Engine
+	config             *utils.Config
+	caseService        *service.CaseService
+	quoteService       *service.QuoteService
+	savedSearchService *service.SavedSearchService
 }
 
 func (a *App) Start() {
+	go a.caseService.RunRedactionBackfill(context.Background())
+	go a.quoteService.RunExpirySweeper(context.Background())
+	go a.savedSearchService.RunAlertWorker(context.Background())
+
 	port := a.config.Port
 	if port == "" {
 		port = "8000"
@@ -18,9 +28,12 @@ func (a *App) Start() {
 	a.router.Run(":" + port)
 }
 
-func NewApp(router *gin.Engine, config *utils.Config) *App {
+func NewApp(router *gin.Engine, config *utils.Config, caseService *service.CaseService, quoteService *service.QuoteService, savedSearchService *service.SavedSearchService) *App {
 	return &App{
-		router: router,
-		config: config,
+		router:             router,
+		config:             config,
+		caseService:        caseService,
+		quoteService:       quoteService,
+		savedSearchService: savedSearchService,
 	}
 }
@@ -0,0 +1,115 @@
+// Package lifecycle defines the case status state machine: which statuses
+// exist, which transitions between them are allowed and who may make them.
+package lifecycle
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	CaseOpen      = "open"
+	CaseEngaged   = "engaged"
+	CaseClosed    = "closed"
+	CaseCancelled = "cancelled"
+)
+
+// Actor is the kind of party requesting a transition.
+type Actor string
+
+const (
+	ActorClient Actor = "client"
+	ActorLawyer Actor = "lawyer"
+	ActorAdmin  Actor = "admin"
+	ActorSystem Actor = "system"
+)
+
+var (
+	ErrUnknownStatus        = errors.New("unknown case status")
+	ErrIllegalTransition    = errors.New("illegal case status transition")
+	ErrTransitionNotAllowed = errors.New("case status transition not allowed for this actor")
+)
+
+// TransitionError reports a rejected transition. It wraps one of
+// ErrUnknownStatus, ErrIllegalTransition or ErrTransitionNotAllowed so callers
+// can use errors.Is.
+type TransitionError struct {
+	From  string
+	To    string
+	Actor Actor
+	Err   error
+}
+
+func (e *TransitionError) Error() string {
+	switch e.Err {
+	case ErrTransitionNotAllowed:
+		return fmt.Sprintf("a %s cannot move a case from %s to %s", e.Actor, e.From, e.To)
+	case ErrUnknownStatus:
+		return fmt.Sprintf("unknown case status transition %q -> %q", e.From, e.To)
+	default:
+		return fmt.Sprintf("case cannot move from %s to %s", e.From, e.To)
+	}
+}
+
+func (e *TransitionError) Unwrap() error {
+	return e.Err
+}
+
+// caseTransitions lists, for each status, the statuses it may move to and the
+// actors allowed to make that move. Closed and cancelled are final.
+var caseTransitions = map[string]map[string][]Actor{
+	CaseOpen: {
+		CaseEngaged:   {ActorSystem},
+		CaseCancelled: {ActorClient, ActorAdmin},
+		CaseClosed:    {ActorAdmin},
+	},
+	CaseEngaged: {
+		CaseClosed:    {ActorClient, ActorAdmin},
+		CaseCancelled: {ActorAdmin},
+	},
+	CaseClosed:    {},
+	CaseCancelled: {},
+}
+
+// CheckCaseTransition returns a *TransitionError if actor may not move a case
+// from one status to the other, and nil otherwise.
+func CheckCaseTransition(from, to string, actor Actor) error {
+	next, ok := caseTransitions[from]
+	if _, known := caseTransitions[to]; !ok || !known {
+		return &TransitionError{From: from, To: to, Actor: actor, Err: ErrUnknownStatus}
+	}
+
+	actors, ok := next[to]
+	if !ok {
+		return &TransitionError{From: from, To: to, Actor: actor, Err: ErrIllegalTransition}
+	}
+	for _, allowed := range actors {
+		if allowed == actor {
+			return nil
+		}
+	}
+	return &TransitionError{From: from, To: to, Actor: actor, Err: ErrTransitionNotAllowed}
+}
+
+// IsFinal reports whether no further transitions are possible from status.
+func IsFinal(status string) bool {
+	return len(caseTransitions[status]) == 0
+}
+
+// IsEditable reports whether the client may still change a case's title,
+// category and description.
+func IsEditable(status string) bool {
+	return status == CaseOpen
+}
+
+// AcceptsQuotes reports whether lawyers may submit, update or have quotes
+// accepted on a case in this status.
+func AcceptsQuotes(status string) bool {
+	return status == CaseOpen
+}
+
+// SharesDetailsWithLawyer reports whether the engaged lawyer may see the full
+// description and files of a case in this status.
+func SharesDetailsWithLawyer(status string) bool {
+	return status == CaseEngaged
+}
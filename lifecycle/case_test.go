@@ -0,0 +1,97 @@
+package lifecycle
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckCaseTransition(t *testing.T) {
+	tests := []struct {
+		name  string
+		from  string
+		to    string
+		actor Actor
+		want  error
+	}{
+		{"system engages open case", CaseOpen, CaseEngaged, ActorSystem, nil},
+		{"client cancels open case", CaseOpen, CaseCancelled, ActorClient, nil},
+		{"admin cancels open case", CaseOpen, CaseCancelled, ActorAdmin, nil},
+		{"admin closes open case", CaseOpen, CaseClosed, ActorAdmin, nil},
+		{"client closes engaged case", CaseEngaged, CaseClosed, ActorClient, nil},
+		{"admin cancels engaged case", CaseEngaged, CaseCancelled, ActorAdmin, nil},
+		{"client cannot engage case", CaseOpen, CaseEngaged, ActorClient, ErrTransitionNotAllowed},
+		{"client cannot close open case", CaseOpen, CaseClosed, ActorClient, ErrTransitionNotAllowed},
+		{"lawyer cannot close engaged case", CaseEngaged, CaseClosed, ActorLawyer, ErrTransitionNotAllowed},
+		{"client cannot cancel engaged case", CaseEngaged, CaseCancelled, ActorClient, ErrTransitionNotAllowed},
+		{"engaged case cannot reopen", CaseEngaged, CaseOpen, ActorAdmin, ErrIllegalTransition},
+		{"closed case is final", CaseClosed, CaseOpen, ActorAdmin, ErrIllegalTransition},
+		{"cancelled case is final", CaseCancelled, CaseEngaged, ActorSystem, ErrIllegalTransition},
+		{"same status is not a transition", CaseOpen, CaseOpen, ActorAdmin, ErrIllegalTransition},
+		{"unknown from status", "archived", CaseClosed, ActorAdmin, ErrUnknownStatus},
+		{"unknown to status", CaseOpen, "archived", ActorAdmin, ErrUnknownStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckCaseTransition(tt.from, tt.to, tt.actor)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("CheckCaseTransition(%q, %q, %q) = %v, want nil", tt.from, tt.to, tt.actor, err)
+				}
+				return
+			}
+
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("CheckCaseTransition(%q, %q, %q) = %v, want %v", tt.from, tt.to, tt.actor, err, tt.want)
+			}
+			var transitionErr *TransitionError
+			if !errors.As(err, &transitionErr) {
+				t.Fatalf("CheckCaseTransition(%q, %q, %q) returned %T, want *TransitionError", tt.from, tt.to, tt.actor, err)
+			}
+		})
+	}
+}
+
+func TestIsFinal(t *testing.T) {
+	tests := []struct {
+		status string
+		want   bool
+	}{
+		{CaseOpen, false},
+		{CaseEngaged, false},
+		{CaseClosed, true},
+		{CaseCancelled, true},
+	}
+
+	for _, tt := range tests {
+		if got := IsFinal(tt.status); got != tt.want {
+			t.Errorf("IsFinal(%q) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestStatusPermissions(t *testing.T) {
+	tests := []struct {
+		status           string
+		editable         bool
+		acceptsQuotes    bool
+		sharesWithLawyer bool
+	}{
+		{CaseOpen, true, true, false},
+		{CaseEngaged, false, false, true},
+		{CaseClosed, false, false, false},
+		{CaseCancelled, false, false, false},
+	}
+
+	for _, tt := range tests {
+		if got := IsEditable(tt.status); got != tt.editable {
+			t.Errorf("IsEditable(%q) = %v, want %v", tt.status, got, tt.editable)
+		}
+		if got := AcceptsQuotes(tt.status); got != tt.acceptsQuotes {
+			t.Errorf("AcceptsQuotes(%q) = %v, want %v", tt.status, got, tt.acceptsQuotes)
+		}
+		if got := SharesDetailsWithLawyer(tt.status); got != tt.sharesWithLawyer {
+			t.Errorf("SharesDetailsWithLawyer(%q) = %v, want %v", tt.status, got, tt.sharesWithLawyer)
+		}
+	}
+}
@@ -7,9 +7,12 @@ import (
 
 
 var (
-	NewS3Client      = providers.NewS3Client
-	NewPresignClient = providers.NewPresignClient
-	NewPusherClient  = providers.NewPusherClient
+	NewS3Client          = providers.NewS3Client
+	NewPresignClient     = providers.NewPresignClient
+	NewPusherClient      = providers.NewPusherClient
+	NewMailer            = providers.NewMailer
+	NewRedactor          = providers.NewRedactor
+	NewQuoteExpiryConfig = providers.NewQuoteExpiryConfig
 )
 
 func main() {
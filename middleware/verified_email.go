@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RequireVerifiedEmail blocks the request until the authenticated user has
+// verified their email address. It must run after AuthMiddleware.
+func RequireVerifiedEmail(repo repository.Repository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exists := c.Get("user_id")
+		if !exists {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "User not found in context"})
+			c.Abort()
+			return
+		}
+
+		userUUID, err := uuid.Parse(userID.(string))
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid user ID"})
+			c.Abort()
+			return
+		}
+
+		user, err := repo.GetUserByID(c.Request.Context(), userUUID)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "User not found"})
+			c.Abort()
+			return
+		}
+
+		if !user.EmailVerifiedAt.Valid {
+			c.JSON(http.StatusForbidden, gin.H{"error": "Email address must be verified"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -3,11 +3,17 @@ package providers
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/gadhittana01/cases-app-server/mailer"
+	"github.com/gadhittana01/cases-app-server/redaction"
+	"github.com/gadhittana01/cases-app-server/service"
 	"github.com/gadhittana01/cases-modules/utils"
 	pusher "github.com/pusher/pusher-http-go/v5"
 )
@@ -44,3 +50,78 @@ func NewPresignClient(s3Client *s3.Client) *s3.PresignClient {
 func NewPusherClient(config *utils.Config) *pusher.Client {
 	return utils.NewPusherClient(config)
 }
+
+
+// NewMailer picks the mail transport from MAIL_DRIVER: "smtp", "file" or
+// "log" (the default).
+func NewMailer() mailer.Mailer {
+	switch utils.GetEnv("MAIL_DRIVER", "log") {
+	case "smtp":
+		return mailer.NewSMTPMailer(
+			utils.GetEnv("SMTP_HOST", ""),
+			utils.GetEnv("SMTP_PORT", "587"),
+			utils.GetEnv("SMTP_USERNAME", ""),
+			utils.GetEnv("SMTP_PASSWORD", ""),
+			utils.GetEnv("MAIL_FROM", "no-reply@example.com"),
+		)
+	case "file":
+		return mailer.NewFileMailer(utils.GetEnv("MAIL_FILE_PATH", "mail.log"))
+	default:
+		return mailer.NewLogMailer()
+	}
+}
+
+
+// NewRedactor builds the marketplace redactor. REDACTION_DETECTORS replaces the
+// default detector list and REDACTION_JURISDICTION_DETECTORS the extra
+// detectors per jurisdiction, written as "singapore=sg_nric,sg_phone;us=us_ssn".
+func NewRedactor() (*redaction.Redactor, error) {
+	defaults := redaction.DefaultDetectors
+	if value := utils.GetEnv("REDACTION_DETECTORS", ""); value != "" {
+		defaults = splitList(value, ",")
+	}
+
+	jurisdictions := redaction.DefaultJurisdictionDetectors
+	if value := utils.GetEnv("REDACTION_JURISDICTION_DETECTORS", ""); value != "" {
+		jurisdictions = map[string][]string{}
+		for _, entry := range splitList(value, ";") {
+			jurisdiction, detectors, ok := strings.Cut(entry, "=")
+			if !ok || strings.TrimSpace(jurisdiction) == "" {
+				return nil, fmt.Errorf("invalid REDACTION_JURISDICTION_DETECTORS entry %q", entry)
+			}
+			jurisdictions[strings.TrimSpace(jurisdiction)] = splitList(detectors, ",")
+		}
+	}
+
+	return redaction.NewRedactor(defaults, jurisdictions)
+}
+
+// NewQuoteExpiryConfig reads QUOTE_VALIDITY_DAYS (default 30), the validity of
+// quotes without valid_until, and QUOTE_EXPIRY_SWEEP_INTERVAL (default 15m),
+// how often stale quotes are expired.
+func NewQuoteExpiryConfig() (service.QuoteExpiryConfig, error) {
+	validityDays, err := strconv.Atoi(utils.GetEnv("QUOTE_VALIDITY_DAYS", "30"))
+	if err != nil || validityDays < 1 {
+		return service.QuoteExpiryConfig{}, fmt.Errorf("invalid QUOTE_VALIDITY_DAYS")
+	}
+
+	sweepInterval, err := time.ParseDuration(utils.GetEnv("QUOTE_EXPIRY_SWEEP_INTERVAL", "15m"))
+	if err != nil || sweepInterval <= 0 {
+		return service.QuoteExpiryConfig{}, fmt.Errorf("invalid QUOTE_EXPIRY_SWEEP_INTERVAL")
+	}
+
+	return service.QuoteExpiryConfig{
+		ValidityDays:  validityDays,
+		SweepInterval: sweepInterval,
+	}, nil
+}
+
+func splitList(value, sep string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, sep) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
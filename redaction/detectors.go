@@ -0,0 +1,119 @@
+package redaction
+
+import "regexp"
+
+// Built-in detector names.
+const (
+	DetectorEmail         = "email"
+	DetectorPhone         = "phone"
+	DetectorIntlPhone     = "intl_phone"
+	DetectorURL           = "url"
+	DetectorSocialHandle  = "social_handle"
+	DetectorStreetAddress = "street_address"
+	DetectorSGNRIC        = "sg_nric"
+	DetectorSGPhone       = "sg_phone"
+	DetectorSGAddress     = "sg_address"
+	DetectorUSSSN         = "us_ssn"
+
+	// DetectorClientName and DetectorClientEmail mark matches of the client's
+	// own details, which are redacted regardless of configuration.
+	DetectorClientName  = "client_name"
+	DetectorClientEmail = "client_email"
+
+	// DetectorManual marks text the client chose to hide themselves.
+	DetectorManual = "manual"
+)
+
+// DefaultDetectors run for every case.
+var DefaultDetectors = []string{
+	DetectorEmail,
+	DetectorPhone,
+	DetectorIntlPhone,
+	DetectorURL,
+	DetectorSocialHandle,
+	DetectorStreetAddress,
+}
+
+// DefaultJurisdictionDetectors add local identifiers on top of
+// DefaultDetectors, keyed by lower-case jurisdiction.
+var DefaultJurisdictionDetectors = map[string][]string{
+	"singapore":     {DetectorSGNRIC, DetectorSGPhone, DetectorSGAddress},
+	"sg":            {DetectorSGNRIC, DetectorSGPhone, DetectorSGAddress},
+	"united states": {DetectorUSSSN},
+	"usa":           {DetectorUSSSN},
+	"us":            {DetectorUSSSN},
+}
+
+func init() {
+	Register(NewPatternDetector(DetectorEmail, "[email redacted]",
+		regexp.MustCompile(`[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}`)))
+	Register(&contextDetector{
+		Detector: NewPatternDetector(DetectorPhone, "[phone redacted]",
+			regexp.MustCompile(`(?:\+?1[-.\s]?)?\(?[0-9]{3}\)?[-.\s]?[0-9]{3}[-.\s]?[0-9]{4}`)),
+		reject: isNotPhoneContext,
+	})
+	Register(NewPatternDetector(DetectorIntlPhone, "[phone redacted]",
+		regexp.MustCompile(`\+[0-9]{1,3}[-.\s]?\(?[0-9]{1,4}\)?(?:[-.\s]?[0-9]{2,4}){2,4}`)))
+	Register(NewPatternDetector(DetectorURL, "[link redacted]",
+		regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"']+`)))
+	Register(NewPatternDetector(DetectorSocialHandle, "[handle redacted]",
+		regexp.MustCompile(`(?:^|[^\w.@])(@[A-Za-z0-9_](?:[A-Za-z0-9_.]{0,28}[A-Za-z0-9_])?)`)))
+	// A house number, a capitalised street name and a street suffix. Suffixes
+	// that are also ordinary words or abbreviations ("court", "close", "way",
+	// "place", "st", "dr") are left out, as they match amounts and durations.
+	Register(NewPatternDetector(DetectorStreetAddress, "[address redacted]",
+		regexp.MustCompile(`\b[0-9]{1,5}[A-Za-z]?\s+(?:[A-Z][A-Za-z'-]*\s+){1,4}(?i:street|road|rd|avenue|ave|lane|ln|drive|boulevard|blvd|crescent|cres|terrace)\b`)))
+	Register(NewPatternDetector(DetectorSGNRIC, "[NRIC redacted]",
+		regexp.MustCompile(`(?i)\b[STFGM][0-9]{7}[A-Z]\b`)))
+	Register(&contextDetector{
+		Detector: NewPatternDetector(DetectorSGPhone, "[phone redacted]",
+			regexp.MustCompile(`(?:\+65[-\s]?)?\b[3689][0-9]{3}[-\s]?[0-9]{4}\b`)),
+		reject: isNotPhoneContext,
+	})
+	// A block number, followed by its street up to the street type and
+	// street number ("Blk 123 Tampines Street 11"), a unit number or a
+	// postal code. Text after the street is left alone.
+	Register(NewPatternDetector(DetectorSGAddress, "[address redacted]",
+		regexp.MustCompile(`(?i)\b(?:blk|block)\s+[0-9]{1,4}[a-z]?\b(?:\s+(?:[a-z'-]+\s+){0,4}?(?:avenue|ave|street|st|road|rd|drive|dr|crescent|cres|lane|ln|close|walk|way|place|pl|link|central|ring|rise|view|terrace|park|grove|boulevard|blvd|court|ct)\b(?:\s+[0-9]{1,3}[a-z]?\b)?)?|#[0-9]{1,3}-[0-9]{1,5}|\bsingapore\s+[0-9]{6}\b`)))
+	Register(NewPatternDetector(DetectorUSSSN, "[SSN redacted]",
+		regexp.MustCompile(`\b[0-9]{3}-[0-9]{2}-[0-9]{4}\b`)))
+}
+
+// contextDetector drops the matches of a detector that the surrounding text
+// shows to be something else.
+type contextDetector struct {
+	Detector
+	reject func(before, after string) bool
+}
+
+func (d *contextDetector) Find(text string) []Span {
+	spans := d.Detector.Find(text)
+	kept := spans[:0]
+	for _, span := range spans {
+		if !d.reject(text[:span.Start], text[span.End:]) {
+			kept = append(kept, span)
+		}
+	}
+	return kept
+}
+
+var (
+	// numberBefore and numberAfter catch a match that is part of a longer
+	// number, e.g. "1,80000000" or "90000000.50".
+	numberBefore = regexp.MustCompile(`(?:[0-9]|[0-9][.,])$`)
+	numberAfter  = regexp.MustCompile(`^(?:[0-9]|[.,][0-9])`)
+
+	amountBefore = regexp.MustCompile(`(?i)(?:[$€£¥]|\b(?:sgd|usd|eur|gbp|aud|myr|rm|worth|cost|costs|paid|pay|price|fee|fees|amount|sum|total))\s*$`)
+	amountAfter  = regexp.MustCompile(`(?i)^\s*(?:dollars?|sgd|usd|eur|gbp|aud|myr)\b`)
+
+	referenceBefore = regexp.MustCompile(`(?i)\b(?:invoice|inv|receipt|ref|reference|order|account|acct|policy|claim|case|ticket|tracking|serial)\.?(?:\s*(?:no|number|num|id)\.?)?\s*[:#]?\s*$`)
+)
+
+// isNotPhoneContext reports whether a number is part of a longer number, reads
+// as a sum of money or follows a document label ("Invoice 1234567890")
+// rather than being a phone number.
+func isNotPhoneContext(before, after string) bool {
+	return numberBefore.MatchString(before) || numberAfter.MatchString(after) ||
+		amountBefore.MatchString(before) || amountAfter.MatchString(after) ||
+		referenceBefore.MatchString(before)
+}
@@ -0,0 +1,184 @@
+package redaction
+
+import (
+	"reflect"
+	"testing"
+)
+
+// findMatches returns the text of every match of the named detector.
+func findMatches(t *testing.T, name, text string) []string {
+	t.Helper()
+
+	detectors, err := lookup([]string{name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	matches := []string{}
+	for _, span := range detectors[0].Find(text) {
+		matches = append(matches, text[span.Start:span.End])
+	}
+	return matches
+}
+
+func TestStreetAddressDetector(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"house number and street", "I live at 221B Baker Street with my family.", []string{"221B Baker Street"}},
+		{"road", "The shop at 12 Orchard Road owes me rent.", []string{"12 Orchard Road"}},
+		{"multi-word street name", "Our office is at 5 Tanjong Pagar Rd since 2019.", []string{"5 Tanjong Pagar Rd"}},
+		{"lower-case suffix", "Deliver to 88 Upper Cross street please.", []string{"88 Upper Cross street"}},
+		{"two addresses", "Moved from 1 Raffles Boulevard to 30 Marine Crescent.", []string{"1 Raffles Boulevard", "30 Marine Crescent"}},
+		{"amount and court", "I am claiming 500 dollars in small claims court.", []string{}},
+		{"count and court", "I made 2 deposits to the court last month.", []string{}},
+		{"duration and close", "The seller has 30 days to close the sale.", []string{}},
+		{"capitalised court", "There were 2 High Court hearings this year.", []string{}},
+		{"capitalised place", "They finished in 3 Different Place settings.", []string{}},
+		{"abbreviated st", "It happened at 10 Downing St in the morning.", []string{}},
+		{"quantity before a road word", "We drove 40 km on the road.", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findMatches(t, DetectorStreetAddress, tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("street_address matches in %q = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSGPhoneDetector(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"spaced number", "Call me on 9123 4567 after work.", []string{"9123 4567"}},
+		{"with country code", "My number is +65 8123-4567.", []string{"+65 8123-4567"}},
+		{"in brackets", "Office line (6123 4567) is unattended.", []string{"6123 4567"}},
+		{"end of sentence", "Reach me at 91234567.", []string{"91234567"}},
+		{"two numbers", "Try 91234567, or 81234567 at night.", []string{"91234567", "81234567"}},
+		{"worth amount", "The flat is worth 80000000 now.", []string{}},
+		{"currency code", "They owe SGD 80000000 in damages.", []string{}},
+		{"dollar sign", "The settlement was S$91234567.", []string{}},
+		{"paid amount", "I paid 61234567 to the contractor.", []string{}},
+		{"dollars after", "A claim of 61234567 dollars was filed.", []string{}},
+		{"longer digit run", "Case reference 3800000001 was assigned.", []string{}},
+		{"thousands separator", "A debt of 1,80000000 remains.", []string{}},
+		{"decimal amount", "Total due is 90000000.50 today.", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findMatches(t, DetectorSGPhone, tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sg_phone matches in %q = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactKeepsLegalText(t *testing.T) {
+	tests := []string{
+		"I am claiming 500 dollars in small claims court.",
+		"I made 2 deposits to the court and have 30 days to close.",
+		"The property is worth 80000000 and the buyer paid 10% upfront.",
+		"My landlord kept the deposit after 12 months, see section 3 of the lease.",
+	}
+
+	redactor := Default()
+	for _, text := range tests {
+		result := redactor.Redact(text, Subject{Jurisdiction: "Singapore"})
+		if result.Text != text || len(result.Matches) != 0 {
+			t.Errorf("Redact(%q) = %q with %d matches, want the text unchanged", text, result.Text, len(result.Matches))
+		}
+	}
+}
+
+func TestRedactHidesContactDetails(t *testing.T) {
+	text := "Write to me at 221B Baker Street or call +65 9123 4567."
+	want := "Write to me at [address redacted] or call [phone redacted]."
+
+	result := Default().Redact(text, Subject{Jurisdiction: "Singapore"})
+	if result.Text != want {
+		t.Errorf("Redact(%q) = %q, want %q", text, result.Text, want)
+	}
+}
+
+func TestPhoneDetector(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"dashed number", "Call me on 555-123-4567 tonight.", []string{"555-123-4567"}},
+		{"bracketed area code", "Office: (555) 123-4567.", []string{"(555) 123-4567"}},
+		{"invoice number", "Invoice 1234567890 is still unpaid.", []string{}},
+		{"reference number", "Quote reference no. 1234567890 when you reply.", []string{}},
+		{"order number", "Order #1234567890 never arrived.", []string{}},
+		{"amount", "They owe me $1234567890 in total.", []string{}},
+		{"longer digit run", "Account 00012345678901 was frozen.", []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findMatches(t, DetectorPhone, tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("phone matches in %q = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSGAddressDetector(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"block, street and unit", "I live at Blk 123 Tampines Street 11 #05-123, near the MRT.", []string{"Blk 123 Tampines Street 11", "#05-123"}},
+		{"block, avenue and postal code", "Send it to Block 7 Ang Mo Kio Avenue 3 Singapore 560007 please.", []string{"Block 7 Ang Mo Kio Avenue 3", "Singapore 560007"}},
+		{"block only", "Blk 45 is where my landlord said the leak started.", []string{"Blk 45"}},
+		{"text after the street", "Blk 9 Jurong West St 91 flooded and the owner refuses to pay.", []string{"Blk 9 Jurong West St 91"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findMatches(t, DetectorSGAddress, tt.text)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sg_address matches in %q = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedactClientName(t *testing.T) {
+	tests := []struct {
+		name       string
+		clientName string
+		text       string
+		want       string
+	}{
+		{"full name", "Tan Wei Ming", "Signed by tan wei ming.", "Signed by [name redacted]."},
+		{"capitalised part", "Tan Wei Ming", "Wei told the landlord.", "[name redacted] told the landlord."},
+		{"after honorific", "Tan Wei Ming", "Mr Tan never replied.", "Mr [name redacted] never replied."},
+		{"lower-case part", "Tan Wei Ming", "He left a tan jacket behind.", "He left a tan jacket behind."},
+		{"common words", "Will Case", "I will file the case myself.", "I will file the case myself."},
+		{"common word at sentence start", "Mark Law", "Law firms quoted twice. Mark the date.", "Law firms quoted twice. Mark the date."},
+		{"common word after honorific", "Mark Law", "Mr Law says the law is clear.", "Mr [name redacted] says the law is clear."},
+		{"common words in full", "Will Case", "The lease names Will Case as tenant.", "The lease names [name redacted] as tenant."},
+	}
+
+	redactor := Default()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := redactor.Redact(tt.text, Subject{Name: tt.clientName}).Text
+			if got != tt.want {
+				t.Errorf("Redact(%q) for %q = %q, want %q", tt.text, tt.clientName, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,304 @@
+// Package redaction strips personal data from case descriptions before they
+// are shown to lawyers in the marketplace. Detectors are registered by name
+// and a Redactor picks which ones run for each jurisdiction.
+package redaction
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Span is a byte range of the input text that a detector wants redacted.
+type Span struct {
+	Start int
+	End   int
+}
+
+// Detector finds one kind of personal data in text.
+type Detector interface {
+	Name() string
+	// Label is the placeholder that replaces each match.
+	Label() string
+	Find(text string) []Span
+}
+
+type patternDetector struct {
+	name    string
+	label   string
+	pattern *regexp.Regexp
+}
+
+// NewPatternDetector returns a detector that redacts every match of pattern.
+// If the pattern has a capturing group, only the first group is redacted, which
+// lets a pattern require context that Go's regexp cannot express as lookbehind.
+func NewPatternDetector(name, label string, pattern *regexp.Regexp) Detector {
+	return &patternDetector{name: name, label: label, pattern: pattern}
+}
+
+func (d *patternDetector) Name() string  { return d.name }
+func (d *patternDetector) Label() string { return d.label }
+
+func (d *patternDetector) Find(text string) []Span {
+	matches := d.pattern.FindAllStringSubmatchIndex(text, -1)
+	spans := make([]Span, 0, len(matches))
+	for _, match := range matches {
+		start, end := match[0], match[1]
+		if len(match) >= 4 && match[2] >= 0 {
+			start, end = match[2], match[3]
+		}
+		if start < end {
+			spans = append(spans, Span{Start: start, End: end})
+		}
+	}
+	return spans
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]Detector{}
+)
+
+// Register makes a detector available to redactors by name. It panics if the
+// name is already taken.
+func Register(detector Detector) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, exists := registry[detector.Name()]; exists {
+		panic(fmt.Sprintf("redaction: detector %q registered twice", detector.Name()))
+	}
+	registry[detector.Name()] = detector
+}
+
+func lookup(names []string) ([]Detector, error) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	detectors := make([]Detector, 0, len(names))
+	for _, name := range names {
+		detector, ok := registry[name]
+		if !ok {
+			return nil, fmt.Errorf("redaction: unknown detector %q", name)
+		}
+		detectors = append(detectors, detector)
+	}
+	return detectors, nil
+}
+
+// Subject describes whose case is being redacted.
+type Subject struct {
+	Jurisdiction string
+	// Name and Email are the client's own details; they are always redacted.
+	Name  string
+	Email string
+	// Phrases are extra text the client marked for redaction; every
+	// occurrence is hidden.
+	Phrases []string
+}
+
+// Match records one redaction, with offsets in characters of the original
+// text. The matched text itself is deliberately not kept.
+type Match struct {
+	Detector string
+	Start    int
+	End      int
+}
+
+type Result struct {
+	Text    string
+	Matches []Match
+}
+
+// Redactor applies the configured detectors. It is safe for concurrent use.
+type Redactor struct {
+	defaults      []Detector
+	jurisdictions map[string][]Detector
+}
+
+// NewRedactor builds a redactor that always runs defaults and additionally the
+// detectors listed for the case's jurisdiction.
+func NewRedactor(defaults []string, jurisdictions map[string][]string) (*Redactor, error) {
+	defaultDetectors, err := lookup(defaults)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Redactor{
+		defaults:      defaultDetectors,
+		jurisdictions: make(map[string][]Detector, len(jurisdictions)),
+	}
+	for jurisdiction, names := range jurisdictions {
+		detectors, err := lookup(names)
+		if err != nil {
+			return nil, err
+		}
+		r.jurisdictions[normalizeJurisdiction(jurisdiction)] = detectors
+	}
+	return r, nil
+}
+
+// Default returns a redactor with DefaultDetectors and
+// DefaultJurisdictionDetectors.
+func Default() *Redactor {
+	r, err := NewRedactor(DefaultDetectors, DefaultJurisdictionDetectors)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+func (r *Redactor) Redact(text string, subject Subject) Result {
+	type labeledSpan struct {
+		Span
+		detector string
+		label    string
+	}
+
+	var spans []labeledSpan
+	add := func(detector Detector) {
+		for _, span := range detector.Find(text) {
+			spans = append(spans, labeledSpan{Span: span, detector: detector.Name(), label: detector.Label()})
+		}
+	}
+
+	for _, detector := range r.subjectDetectors(subject) {
+		add(detector)
+	}
+	for _, detector := range r.defaults {
+		add(detector)
+	}
+	for _, detector := range r.jurisdictions[normalizeJurisdiction(subject.Jurisdiction)] {
+		add(detector)
+	}
+
+	// Earliest span first; of spans starting together the longest wins.
+	// Overlapping spans are merged into the first one.
+	sort.SliceStable(spans, func(i, j int) bool {
+		if spans[i].Start != spans[j].Start {
+			return spans[i].Start < spans[j].Start
+		}
+		return spans[i].End > spans[j].End
+	})
+	merged := make([]labeledSpan, 0, len(spans))
+	for _, span := range spans {
+		if n := len(merged); n > 0 && span.Start < merged[n-1].End {
+			if span.End > merged[n-1].End {
+				merged[n-1].End = span.End
+			}
+			continue
+		}
+		merged = append(merged, span)
+	}
+
+	var out strings.Builder
+	matches := make([]Match, 0, len(merged))
+	last := 0
+	for _, span := range merged {
+		out.WriteString(maskAtSigns(text[last:span.Start]))
+		out.WriteString(span.label)
+		matches = append(matches, Match{
+			Detector: span.detector,
+			Start:    utf8.RuneCountInString(text[:span.Start]),
+			End:      utf8.RuneCountInString(text[:span.End]),
+		})
+		last = span.End
+	}
+	out.WriteString(maskAtSigns(text[last:]))
+
+	return Result{Text: out.String(), Matches: matches}
+}
+
+// subjectDetectors builds detectors for the client's own name and email and
+// the phrases they marked.
+func (r *Redactor) subjectDetectors(subject Subject) []Detector {
+	var detectors []Detector
+
+	if email := strings.TrimSpace(subject.Email); email != "" {
+		detectors = append(detectors, NewPatternDetector(DetectorClientEmail, "[email redacted]",
+			regexp.MustCompile(`(?i)`+regexp.QuoteMeta(email))))
+	}
+
+	if name := strings.Join(strings.Fields(subject.Name), " "); name != "" {
+		detectors = append(detectors, nameDetectors(name)...)
+	}
+
+	phrases := []string{}
+	for _, phrase := range subject.Phrases {
+		if phrase = strings.TrimSpace(phrase); phrase != "" {
+			phrases = append(phrases, regexp.QuoteMeta(phrase))
+		}
+	}
+	if len(phrases) > 0 {
+		// Longest first, so a phrase containing another is hidden whole.
+		sort.Slice(phrases, func(i, j int) bool { return len(phrases[i]) > len(phrases[j]) })
+		detectors = append(detectors, NewPatternDetector(DetectorManual, "[redacted]",
+			regexp.MustCompile(`(?i)(?:`+strings.Join(phrases, "|")+`)`)))
+	}
+
+	return detectors
+}
+
+// honorifics introduce a name, whatever the word after them.
+const honorifics = `(?:mr|mrs|ms|miss|mdm|madam|dr)\.?`
+
+// commonWordNames are name parts that are also ordinary words. On their own
+// they are only redacted after an honorific, so "Will" or "Law" in a name does
+// not hide every "will" and "law" in the description.
+var commonWordNames = map[string]bool{
+	"april": true, "art": true, "august": true, "baker": true, "bell": true,
+	"bill": true, "black": true, "bond": true, "brown": true, "case": true,
+	"chance": true, "chase": true, "cook": true, "dean": true, "drew": true,
+	"faith": true, "field": true, "fine": true, "frank": true, "grace": true,
+	"grant": true, "green": true, "hall": true, "hill": true, "hope": true,
+	"hunter": true, "jack": true, "joy": true, "judge": true, "king": true,
+	"lane": true, "law": true, "long": true, "lord": true, "love": true,
+	"mark": true, "may": true, "miller": true, "park": true, "price": true,
+	"rich": true, "rose": true, "small": true, "star": true, "summer": true,
+	"trust": true, "white": true, "will": true, "young": true,
+}
+
+// nameDetectors match the client's full name anywhere, and each part of the
+// name of three or more letters where it reads as a name: after an honorific,
+// so "Mr Tan" is caught for a client named "Tan Wei Ming", or capitalised,
+// unless the part is one of commonWordNames.
+func nameDetectors(name string) []Detector {
+	detectors := []Detector{NewPatternDetector(DetectorClientName, "[name redacted]",
+		regexp.MustCompile(`(?i)\b`+regexp.QuoteMeta(name)+`\b`))}
+
+	parts, capitalised := []string{}, []string{}
+	for _, part := range strings.Fields(name) {
+		if utf8.RuneCountInString(part) < 3 {
+			continue
+		}
+		parts = append(parts, regexp.QuoteMeta(part))
+		if !commonWordNames[strings.ToLower(part)] {
+			first, size := utf8.DecodeRuneInString(part)
+			capitalised = append(capitalised,
+				regexp.QuoteMeta(string(unicode.ToUpper(first)))+`(?i:`+regexp.QuoteMeta(part[size:])+`)`)
+		}
+	}
+	if len(parts) > 0 {
+		detectors = append(detectors, NewPatternDetector(DetectorClientName, "[name redacted]",
+			regexp.MustCompile(`(?i)\b`+honorifics+`\s+(`+strings.Join(parts, "|")+`)\b`)))
+	}
+	if len(capitalised) > 0 {
+		detectors = append(detectors, NewPatternDetector(DetectorClientName, "[name redacted]",
+			regexp.MustCompile(`\b(?:`+strings.Join(capitalised, "|")+`)\b`)))
+	}
+	return detectors
+}
+
+// maskAtSigns hides stray "@" characters left after detection, so partial or
+// obfuscated addresses do not read as contact details.
+func maskAtSigns(text string) string {
+	return strings.ReplaceAll(text, "@", "[at]")
+}
+
+func normalizeJurisdiction(jurisdiction string) string {
+	return strings.ToLower(strings.TrimSpace(jurisdiction))
+}
@@ -3,7 +3,9 @@ package routes
 import (
 	"log"
 
+	"github.com/gadhittana01/cases-app-server/db/repository"
 	"github.com/gadhittana01/cases-app-server/handler"
+	appMiddleware "github.com/gadhittana01/cases-app-server/middleware"
 	"github.com/gadhittana01/cases-modules/middleware"
 	"github.com/gadhittana01/cases-modules/utils"
 	"github.com/gin-gonic/gin"
@@ -17,9 +19,16 @@ func SetupRoutes(
 	paymentHandler *handler.PaymentHandler,
 	fileHandler *handler.FileHandler,
 	webhookHandler *handler.WebhookHandler,
+	lawyerVerificationHandler *handler.LawyerVerificationHandler,
+	adminHandler *handler.AdminHandler,
+	categoryHandler *handler.CategoryHandler,
+	savedSearchHandler *handler.SavedSearchHandler,
+	notificationHandler *handler.NotificationHandler,
+	repo repository.Repository,
 	config *utils.Config,
 ) *gin.Engine {
 	jwtSecret := config.JWTSecret
+	requireVerifiedEmail := appMiddleware.RequireVerifiedEmail(repo)
 	r := gin.Default()
 
 	r.Use(middleware.CORS())
@@ -34,22 +43,49 @@ func SetupRoutes(
 		public.POST("/auth/signup/client", userHandler.Signup)
 		public.POST("/auth/signup/lawyer", userHandler.Signup)
 		public.POST("/auth/login", userHandler.Login)
+		public.POST("/auth/refresh", userHandler.RefreshToken)
+		public.POST("/auth/forgot-password", userHandler.ForgotPassword)
+		public.POST("/auth/reset-password", userHandler.ResetPassword)
+		public.POST("/auth/verify-email", userHandler.VerifyEmail)
+		public.GET("/categories", categoryHandler.ListCategories)
 		public.POST("/webhooks/stripe", webhookHandler.HandleStripeWebhook)
 	}
 
 	api := r.Group("/api/v1")
-	api.Use(middleware.AuthMiddleware(jwtSecret))
+	api.Use(appMiddleware.AuthMiddleware(jwtSecret, repo))
 	{
 		api.GET("/auth/profile", userHandler.GetProfile)
+		api.PATCH("/auth/profile", userHandler.UpdateProfile)
+		api.POST("/auth/change-password", userHandler.ChangePassword)
+		api.POST("/auth/logout", userHandler.Logout)
+		api.POST("/auth/verify-email/resend", userHandler.ResendVerificationEmail)
+		api.GET("/notifications", notificationHandler.ListNotifications)
+		api.POST("/notifications/read-all", notificationHandler.MarkAllRead)
+		api.POST("/notifications/:id/read", notificationHandler.MarkRead)
 
 		client := api.Group("")
 		client.Use(middleware.RequireRole("client"))
 		{
 			client.GET("/client/cases", caseHandler.GetMyCases)
-			client.POST("/client/cases", caseHandler.CreateCase)
+			client.POST("/client/cases", requireVerifiedEmail, caseHandler.CreateCase)
 			client.GET("/client/cases/:id", caseHandler.GetCaseByID)
+			client.PUT("/client/cases/:id", caseHandler.UpdateCase)
+			client.GET("/client/cases/:id/marketplace-preview", caseHandler.GetMarketplacePreview)
+			client.PUT("/client/cases/:id/redactions", caseHandler.UpdateRedactions)
 			client.POST("/client/cases/:id/files", caseHandler.UploadFile)
+			client.POST("/client/cases/:id/cancel", caseHandler.CancelCase)
+			client.POST("/client/cases/:id/close", caseHandler.CloseCase)
+			client.POST("/client/cases/:id/review", caseHandler.ReviewCase)
+			client.GET("/client/cases/:id/quotes/compare", quoteHandler.CompareQuotes)
 			client.POST("/client/quotes/accept", paymentHandler.AcceptQuote)
+			client.POST("/client/quotes/:id/decline", quoteHandler.DeclineQuote)
+			client.POST("/client/quotes/:id/reopen", quoteHandler.ReopenBidding)
+			client.GET("/client/quotes/:id/offers", quoteHandler.GetQuoteOffers)
+			client.POST("/client/quotes/:id/offers", quoteHandler.ProposeOffer)
+			client.POST("/client/quotes/:id/offers/:offer_id/accept", quoteHandler.AcceptOffer)
+			client.POST("/client/quotes/:id/offers/:offer_id/decline", quoteHandler.DeclineOffer)
+			client.POST("/client/quotes/:id/milestones/:milestone_id/approve", paymentHandler.ApproveMilestone)
+			client.POST("/client/quotes/:id/milestones/:milestone_id/pay", paymentHandler.PayMilestone)
 		}
 
 		lawyer := api.Group("")
@@ -58,9 +94,39 @@ func SetupRoutes(
 			lawyer.GET("/lawyer/marketplace", marketplaceHandler.ListOpenCases)
 			lawyer.GET("/lawyer/marketplace/cases/:id", marketplaceHandler.GetCaseForMarketplace)
 			lawyer.GET("/lawyer/marketplace/cases/:id/quotes/my", quoteHandler.GetMyQuoteForCase)
-			lawyer.POST("/lawyer/marketplace/cases/:id/quotes", quoteHandler.CreateQuote)
+			lawyer.POST("/lawyer/marketplace/cases/:id/quotes", requireVerifiedEmail, quoteHandler.CreateQuote)
 			lawyer.PUT("/lawyer/marketplace/cases/:id/quotes", quoteHandler.UpdateQuote)
+			lawyer.POST("/lawyer/marketplace/cases/:id/quotes/withdraw", quoteHandler.WithdrawQuote)
 			lawyer.GET("/lawyer/quotes", quoteHandler.GetMyQuotes)
+			lawyer.GET("/lawyer/quotes/:id/offers", quoteHandler.GetQuoteOffers)
+			lawyer.POST("/lawyer/quotes/:id/offers", quoteHandler.ProposeOffer)
+			lawyer.POST("/lawyer/quotes/:id/offers/:offer_id/accept", quoteHandler.AcceptOffer)
+			lawyer.POST("/lawyer/quotes/:id/offers/:offer_id/decline", quoteHandler.DeclineOffer)
+			lawyer.POST("/lawyer/quotes/:id/milestones/:milestone_id/deliver", quoteHandler.DeliverMilestone)
+			lawyer.POST("/lawyer/quotes/:id/top-ups", paymentHandler.RequestTopUp)
+			lawyer.GET("/lawyer/saved-searches", savedSearchHandler.ListSavedSearches)
+			lawyer.POST("/lawyer/saved-searches", savedSearchHandler.CreateSavedSearch)
+			lawyer.DELETE("/lawyer/saved-searches/:id", savedSearchHandler.DeleteSavedSearch)
+			lawyer.GET("/lawyer/verification", lawyerVerificationHandler.GetMyVerification)
+			lawyer.POST("/lawyer/verification", lawyerVerificationHandler.SubmitVerification)
+			lawyer.POST("/lawyer/verification/documents", lawyerVerificationHandler.UploadDocument)
+		}
+
+		admin := api.Group("/admin")
+		admin.Use(middleware.RequireRole("admin"))
+		{
+			admin.GET("/lawyer-verifications", lawyerVerificationHandler.ListVerifications)
+			admin.GET("/lawyer-verifications/:id", lawyerVerificationHandler.GetVerification)
+			admin.POST("/lawyer-verifications/:id/approve", lawyerVerificationHandler.ApproveVerification)
+			admin.POST("/lawyer-verifications/:id/reject", lawyerVerificationHandler.RejectVerification)
+
+			admin.GET("/users", adminHandler.ListUsers)
+			admin.POST("/users/:id/suspend", adminHandler.SuspendUser)
+			admin.POST("/users/:id/reactivate", adminHandler.ReactivateUser)
+			admin.POST("/cases/:id/close", adminHandler.CloseCase)
+			admin.POST("/cases/:id/cancel", adminHandler.CancelCase)
+			admin.POST("/quotes/:id/void", adminHandler.VoidQuote)
+			admin.GET("/actions", adminHandler.ListActions)
 		}
 
 		api.GET("/files/:id/download", fileHandler.GenerateDownloadURL)
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/lifecycle"
+	"github.com/gadhittana01/cases-modules/utils"
+	dbUtils "github.com/gadhittana01/cases-modules/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	AdminActionSuspendUser    = "suspend_user"
+	AdminActionReactivateUser = "reactivate_user"
+	AdminActionCloseCase      = "close_case"
+	AdminActionCancelCase     = "cancel_case"
+	AdminActionVoidQuote      = "void_quote"
+)
+
+type AdminService struct {
+	repo repository.Repository
+}
+
+func NewAdminService(repo repository.Repository) *AdminService {
+	return &AdminService{
+		repo: repo,
+	}
+}
+
+func (s *AdminService) ListUsers(ctx context.Context, filters dto.AdminUserFilters) ([]dto.UserResponse, int64, error) {
+	page := filters.Page
+	pageSize := filters.PageSize
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	offset := (page - 1) * pageSize
+
+	users, err := s.repo.ListUsers(ctx, &repository.ListUsersParams{
+		Column1: filters.Role,
+		Column2: filters.Query,
+		Limit:   int32(pageSize),
+		Offset:  int32(offset),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	total, err := s.repo.CountUsers(ctx, &repository.CountUsersParams{
+		Column1: filters.Role,
+		Column2: filters.Query,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	result := make([]dto.UserResponse, 0, len(users))
+	for _, user := range users {
+		result = append(result, userToResponse(user))
+	}
+
+	return result, total, nil
+}
+
+// SuspendUser blocks an account from logging in and revokes all of its
+// sessions, so existing access tokens stop working immediately.
+func (s *AdminService) SuspendUser(ctx context.Context, adminID, userID uuid.UUID, reason string) (*dto.UserResponse, error) {
+	if adminID == userID {
+		return nil, fmt.Errorf("you cannot suspend your own account")
+	}
+
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	if user.SuspendedAt.Valid {
+		return nil, fmt.Errorf("user is already suspended")
+	}
+
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		user, err = txRepo.SuspendUser(ctx, &repository.SuspendUserParams{
+			ID:               userID,
+			SuspensionReason: utils.ToPgtypeText(&reason),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to suspend user: %w", err)
+		}
+
+		if err := txRepo.RevokeUserSessions(ctx, userID); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+
+		return recordAdminAction(ctx, txRepo, adminID, AdminActionSuspendUser, "user", userID, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := userToResponse(user)
+	return &response, nil
+}
+
+func (s *AdminService) ReactivateUser(ctx context.Context, adminID, userID uuid.UUID, reason string) (*dto.UserResponse, error) {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	if !user.SuspendedAt.Valid {
+		return nil, fmt.Errorf("user is not suspended")
+	}
+
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		user, err = txRepo.ReactivateUser(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("failed to reactivate user: %w", err)
+		}
+
+		return recordAdminAction(ctx, txRepo, adminID, AdminActionReactivateUser, "user", userID, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := userToResponse(user)
+	return &response, nil
+}
+
+func (s *AdminService) CloseCase(ctx context.Context, adminID, caseID uuid.UUID, reason string) (*dto.CaseResponse, error) {
+	return s.endCase(ctx, adminID, caseID, lifecycle.CaseClosed, AdminActionCloseCase, reason)
+}
+
+func (s *AdminService) CancelCase(ctx context.Context, adminID, caseID uuid.UUID, reason string) (*dto.CaseResponse, error) {
+	return s.endCase(ctx, adminID, caseID, lifecycle.CaseCancelled, AdminActionCancelCase, reason)
+}
+
+// endCase moves a case to a final status, rejects the quotes still waiting on
+// it and cancels any unpaid payment links.
+func (s *AdminService) endCase(ctx context.Context, adminID, caseID uuid.UUID, status, action, reason string) (*dto.CaseResponse, error) {
+	var caseRecord *repository.Case
+	var cancelledPayments []*repository.Payment
+	err := dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		var err error
+		caseRecord, err = transitionCase(ctx, txRepo, caseID, status, caseActor{ID: &adminID, Role: lifecycle.ActorAdmin}, reason)
+		if err != nil {
+			return err
+		}
+
+		if _, err := txRepo.RejectProposedQuotesByCaseID(ctx, caseID); err != nil {
+			return fmt.Errorf("failed to reject quotes: %w", err)
+		}
+
+		cancelledPayments, err = txRepo.CancelPendingPaymentsByCaseID(ctx, caseID)
+		if err != nil {
+			return fmt.Errorf("failed to cancel pending payments: %w", err)
+		}
+
+		return recordAdminAction(ctx, txRepo, adminID, action, "case", caseID, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deactivatePaymentLinks(cancelledPayments)
+
+	return caseToResponse(caseRecord), nil
+}
+
+// VoidQuote withdraws a proposed quote on the lawyer's behalf, e.g. for
+// spam or abuse, and cancels any payment link the client has not paid yet.
+func (s *AdminService) VoidQuote(ctx context.Context, adminID, quoteID uuid.UUID, reason string) (*dto.QuoteResponse, error) {
+	var quote *repository.Quote
+	var cancelledPayments []*repository.Payment
+	err := dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		var err error
+		quote, err = txRepo.VoidQuote(ctx, quoteID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("quote not found or no longer proposed")
+			}
+			return fmt.Errorf("failed to void quote: %w", err)
+		}
+
+		cancelledPayments, err = txRepo.CancelPendingPaymentsByQuoteID(ctx, quoteID)
+		if err != nil {
+			return fmt.Errorf("failed to cancel pending payments: %w", err)
+		}
+
+		return recordAdminAction(ctx, txRepo, adminID, AdminActionVoidQuote, "quote", quoteID, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deactivatePaymentLinks(cancelledPayments)
+
+	return quoteToResponse(quote), nil
+}
+
+func (s *AdminService) ListActions(ctx context.Context, targetType string, page, pageSize int) ([]dto.AdminActionResponse, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	offset := (page - 1) * pageSize
+
+	actions, err := s.repo.ListAdminActions(ctx, &repository.ListAdminActionsParams{
+		Column1: targetType,
+		Limit:   int32(pageSize),
+		Offset:  int32(offset),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list admin actions: %w", err)
+	}
+
+	total, err := s.repo.CountAdminActions(ctx, targetType)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count admin actions: %w", err)
+	}
+
+	result := make([]dto.AdminActionResponse, 0, len(actions))
+	for _, action := range actions {
+		result = append(result, dto.AdminActionResponse{
+			ID:         action.ID,
+			AdminID:    action.AdminID,
+			AdminName:  utils.GetNullableString(action.AdminName),
+			AdminEmail: action.AdminEmail,
+			Action:     action.Action,
+			TargetType: action.TargetType,
+			TargetID:   action.TargetID,
+			Reason:     utils.GetNullableString(action.Reason),
+			CreatedAt:  utils.PgtypeTimeToTime(action.CreatedAt),
+		})
+	}
+
+	return result, total, nil
+}
+
+func recordAdminAction(ctx context.Context, repo repository.Querier, adminID uuid.UUID, action, targetType string, targetID uuid.UUID, reason string) error {
+	var reasonPtr *string
+	if reason != "" {
+		reasonPtr = &reason
+	}
+
+	if _, err := repo.CreateAdminAction(ctx, &repository.CreateAdminActionParams{
+		AdminID:    adminID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Reason:     utils.ToPgtypeText(reasonPtr),
+	}); err != nil {
+		return fmt.Errorf("failed to record admin action: %w", err)
+	}
+	return nil
+}
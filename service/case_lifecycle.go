@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/lifecycle"
+	"github.com/gadhittana01/cases-modules/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// caseActor identifies who is changing a case's status. ID is nil for
+// system-driven changes such as a payment webhook.
+type caseActor struct {
+	ID   *uuid.UUID
+	Role lifecycle.Actor
+}
+
+// transitionCase is the only place a case's status changes. It checks the
+// move against the lifecycle rules, applies it only if the case is still in
+// the status it was read in, and records it in case_status_history. Callers
+// should run it inside a transaction together with the side effects.
+func transitionCase(ctx context.Context, repo repository.Querier, caseID uuid.UUID, to string, actor caseActor, reason string) (*repository.Case, error) {
+	current, err := repo.GetCaseByID(ctx, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("case not found: %w", err)
+	}
+
+	if err := lifecycle.CheckCaseTransition(current.Status, to, actor.Role); err != nil {
+		return nil, err
+	}
+
+	caseRecord, err := repo.TransitionCaseStatus(ctx, &repository.TransitionCaseStatusParams{
+		ToStatus:   to,
+		ID:         caseID,
+		FromStatus: current.Status,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("case status changed while processing the request, please retry")
+		}
+		return nil, fmt.Errorf("failed to update case status: %w", err)
+	}
+
+	if err := recordCaseStatus(ctx, repo, caseID, &current.Status, to, actor, reason); err != nil {
+		return nil, err
+	}
+
+	return caseRecord, nil
+}
+
+func recordCaseStatus(ctx context.Context, repo repository.Querier, caseID uuid.UUID, from *string, to string, actor caseActor, reason string) error {
+	var reasonPtr *string
+	if reason != "" {
+		reasonPtr = &reason
+	}
+
+	if _, err := repo.CreateCaseStatusHistory(ctx, &repository.CreateCaseStatusHistoryParams{
+		CaseID:     caseID,
+		FromStatus: utils.ToPgtypeText(from),
+		ToStatus:   to,
+		ActorID:    utils.UUIDToPgtypeUUID(actor.ID),
+		ActorRole:  string(actor.Role),
+		Reason:     utils.ToPgtypeText(reasonPtr),
+	}); err != nil {
+		return fmt.Errorf("failed to record case status history: %w", err)
+	}
+	return nil
+}
+
+func caseTimeline(history []*repository.GetCaseStatusHistoryRow) []dto.CaseStatusChangeResponse {
+	timeline := make([]dto.CaseStatusChangeResponse, 0, len(history))
+	for _, entry := range history {
+		change := dto.CaseStatusChangeResponse{
+			FromStatus: utils.GetNullableString(entry.FromStatus),
+			ToStatus:   entry.ToStatus,
+			ActorRole:  entry.ActorRole,
+			ActorID:    utils.PgtypeUUIDToUUID(entry.ActorID),
+			ActorName:  utils.GetNullableString(entry.ActorName),
+			Reason:     utils.GetNullableString(entry.Reason),
+			CreatedAt:  utils.PgtypeTimeToTime(entry.CreatedAt),
+		}
+		timeline = append(timeline, change)
+	}
+	return timeline
+}
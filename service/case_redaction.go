@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/redaction"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// caseRedactionVersion identifies the detectors stored descriptions are
+	// redacted with. Bump it when a detector changes, so RedactStaleCases
+	// redacts the stored descriptions again.
+	caseRedactionVersion   = 2
+	caseRedactionBatchSize = 100
+)
+
+// redactionSubject describes a case's client to the redactor, so their own
+// name and email are removed alongside whatever the detectors find.
+func redactionSubject(jurisdiction, clientName pgtype.Text, clientEmail string) redaction.Subject {
+	return redaction.Subject{
+		Jurisdiction: jurisdiction.String,
+		Name:         clientName.String,
+		Email:        clientEmail,
+	}
+}
+
+// isRedactionCurrent reports whether a stored redaction was made with the
+// current detectors.
+func isRedactionCurrent(redactedAt pgtype.Timestamptz, version int32) bool {
+	return redactedAt.Valid && version >= caseRedactionVersion
+}
+
+// marketplaceDescription is what lawyers see of a case description: the
+// stored redaction, or, until RedactStaleCases reaches the case, a fresh one
+// that also hides the client's manual redactions.
+func marketplaceDescription(ctx context.Context, repo repository.Querier, redactor *redaction.Redactor, caseID uuid.UUID, description, redactedDescription string, redactedAt pgtype.Timestamptz, version int32, subject redaction.Subject) (string, error) {
+	if isRedactionCurrent(redactedAt, version) {
+		return redactedDescription, nil
+	}
+
+	phrases, err := manualRedactionPhrases(ctx, repo, caseID)
+	if err != nil {
+		return "", err
+	}
+	subject.Phrases = phrases
+	return redactor.Redact(description, subject).Text, nil
+}
+
+// saveCaseRedactions replaces the redaction audit of a case.
+func saveCaseRedactions(ctx context.Context, repo repository.Querier, caseID uuid.UUID, matches []redaction.Match) error {
+	if err := repo.DeleteCaseRedactions(ctx, caseID); err != nil {
+		return fmt.Errorf("failed to clear case redactions: %w", err)
+	}
+
+	for _, match := range matches {
+		_, err := repo.CreateCaseRedaction(ctx, &repository.CreateCaseRedactionParams{
+			CaseID:      caseID,
+			Detector:    match.Detector,
+			StartOffset: int32(match.Start),
+			EndOffset:   int32(match.End),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record case redaction: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func manualRedactionPhrases(ctx context.Context, repo repository.Querier, caseID uuid.UUID) ([]string, error) {
+	manual, err := repo.GetCaseManualRedactions(ctx, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get manual redactions: %w", err)
+	}
+
+	phrases := make([]string, 0, len(manual))
+	for _, m := range manual {
+		phrases = append(phrases, m.Phrase)
+	}
+	return phrases, nil
+}
+
+// saveManualRedactions replaces the phrases the client marked for redaction.
+func saveManualRedactions(ctx context.Context, repo repository.Querier, caseID uuid.UUID, phrases []string) error {
+	if err := repo.DeleteCaseManualRedactions(ctx, caseID); err != nil {
+		return fmt.Errorf("failed to clear manual redactions: %w", err)
+	}
+
+	for _, phrase := range phrases {
+		_, err := repo.CreateCaseManualRedaction(ctx, &repository.CreateCaseManualRedactionParams{
+			CaseID: caseID,
+			Phrase: phrase,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save manual redaction: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// spansToPhrases reads the text the client marked in a description. Phrases
+// are stored rather than offsets so they still apply after the description
+// is edited.
+func spansToPhrases(description string, spans []dto.RedactionSpan) ([]string, error) {
+	runes := []rune(description)
+	seen := map[string]bool{}
+	phrases := []string{}
+	for _, span := range spans {
+		if span.Start < 0 || span.End > len(runes) || span.Start >= span.End {
+			return nil, fmt.Errorf("redaction %d-%d is outside the description", span.Start, span.End)
+		}
+		phrase := strings.TrimSpace(string(runes[span.Start:span.End]))
+		if phrase == "" || seen[strings.ToLower(phrase)] {
+			continue
+		}
+		seen[strings.ToLower(phrase)] = true
+		phrases = append(phrases, phrase)
+	}
+	return phrases, nil
+}
+
+func caseRedactionsToResponse(description string, redactions []*repository.CaseRedaction) []dto.CaseRedactionResponse {
+	runes := []rune(description)
+	result := make([]dto.CaseRedactionResponse, 0, len(redactions))
+	for _, r := range redactions {
+		start, end := int(r.StartOffset), int(r.EndOffset)
+		var text string
+		if start >= 0 && start <= end && end <= len(runes) {
+			text = string(runes[start:end])
+		}
+		result = append(result, dto.CaseRedactionResponse{
+			Detector: r.Detector,
+			Start:    start,
+			End:      end,
+			Text:     text,
+		})
+	}
+	return result
+}
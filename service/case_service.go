@@ -2,50 +2,121 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/gadhittana01/cases-app-server/db/repository"
 	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/lifecycle"
+	"github.com/gadhittana01/cases-app-server/redaction"
 	"github.com/gadhittana01/cases-modules/utils"
+	dbUtils "github.com/gadhittana01/cases-modules/utils"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	pusher "github.com/pusher/pusher-http-go/v5"
 	"github.com/shopspring/decimal"
 )
 
 type CaseService struct {
-	repo repository.Repository
+	repo               repository.Repository
+	pusherClient       *pusher.Client
+	savedSearchService *SavedSearchService
+	redactor           *redaction.Redactor
 }
 
-func NewCaseService(repo repository.Repository) *CaseService {
+func NewCaseService(repo repository.Repository, pusherClient *pusher.Client, savedSearchService *SavedSearchService, redactor *redaction.Redactor) *CaseService {
 	return &CaseService{
-		repo: repo,
+		repo:               repo,
+		pusherClient:       pusherClient,
+		savedSearchService: savedSearchService,
+		redactor:           redactor,
 	}
 }
 
 func (s *CaseService) CreateCase(ctx context.Context, clientID uuid.UUID, req dto.CreateCaseRequest) (*dto.CaseResponse, error) {
-	caseRecord, err := s.repo.CreateCase(ctx, &repository.CreateCaseParams{
-		ClientID:    clientID,
-		Title:       req.Title,
-		Category:    req.Category,
-		Description: req.Description,
-		Status:      "open",
+	if err := validateCategory(ctx, s.repo, req.Category); err != nil {
+		return nil, err
+	}
+	if req.Deadline != nil && !req.Deadline.After(time.Now()) {
+		return nil, errors.New("deadline must be in the future")
+	}
+	jurisdictionValue := optionalString(req.Jurisdiction)
+	if jurisdictionValue == nil {
+		return nil, errors.New("jurisdiction is required")
+	}
+
+	client, err := s.repo.GetUserByID(ctx, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	phrases, err := spansToPhrases(req.Description, req.Redactions)
+	if err != nil {
+		return nil, err
+	}
+	jurisdiction := utils.ToPgtypeText(jurisdictionValue)
+	subject := redactionSubject(jurisdiction, client.Name, client.Email)
+	subject.Phrases = phrases
+	redacted := s.redactor.Redact(req.Description, subject)
+
+	var caseRecord *repository.Case
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		var err error
+		caseRecord, err = txRepo.CreateCase(ctx, &repository.CreateCaseParams{
+			ClientID:            clientID,
+			Title:               req.Title,
+			Category:            req.Category,
+			Description:         req.Description,
+			Status:              lifecycle.CaseOpen,
+			RedactedDescription: redacted.Text,
+			Jurisdiction:        jurisdiction,
+			Deadline:            utils.ToPgtypeTimestamptz(req.Deadline),
+			RedactionVersion:    caseRedactionVersion,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create case: %w", err)
+		}
+
+		if err := saveManualRedactions(ctx, txRepo, caseRecord.ID, phrases); err != nil {
+			return err
+		}
+		if err := saveCaseRedactions(ctx, txRepo, caseRecord.ID, redacted.Matches); err != nil {
+			return err
+		}
+
+		return recordCaseStatus(ctx, txRepo, caseRecord.ID, nil, lifecycle.CaseOpen, caseActor{ID: &clientID, Role: lifecycle.ActorClient}, "")
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create case: %w", err)
+		return nil, err
 	}
 
+	s.savedSearchService.QueueAlerts(caseRecord.ID)
+
+	return caseToResponse(caseRecord), nil
+}
+
+func caseToResponse(caseRecord *repository.Case) *dto.CaseResponse {
 	return &dto.CaseResponse{
-		ID:          caseRecord.ID,
-		ClientID:    caseRecord.ClientID,
-		Title:       caseRecord.Title,
-		Category:    caseRecord.Category,
-		Description: caseRecord.Description,
-		Status:      caseRecord.Status,
-		CreatedAt:   utils.PgtypeTimeToTime(caseRecord.CreatedAt),
-		UpdatedAt:   utils.PgtypeTimeToTime(caseRecord.UpdatedAt),
-	}, nil
+		ID:           caseRecord.ID,
+		ClientID:     caseRecord.ClientID,
+		Title:        caseRecord.Title,
+		Category:     caseRecord.Category,
+		Description:  caseRecord.Description,
+		Jurisdiction: utils.GetNullableString(caseRecord.Jurisdiction),
+		Deadline:     nullableTime(caseRecord.Deadline),
+		Status:       caseRecord.Status,
+		CreatedAt:    utils.PgtypeTimeToTime(caseRecord.CreatedAt),
+		UpdatedAt:    utils.PgtypeTimeToTime(caseRecord.UpdatedAt),
+	}
 }
 
-func (s *CaseService) GetCasesByClientID(ctx context.Context, clientID uuid.UUID, page, pageSize int) ([]dto.CaseWithQuotesResponse, int64, error) {
+// GetCasesByClientID returns a page of the client's cases, newest first. A
+// non-empty cursor switches to keyset pagination, which skips the total count.
+func (s *CaseService) GetCasesByClientID(ctx context.Context, clientID uuid.UUID, page, pageSize int, cursorToken string) ([]dto.CaseWithQuotesResponse, int64, string, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -54,18 +125,41 @@ func (s *CaseService) GetCasesByClientID(ctx context.Context, clientID uuid.UUID
 	}
 	offset := (page - 1) * pageSize
 
-	cases, err := s.repo.GetCasesByClientID(ctx, &repository.GetCasesByClientIDParams{
+	cursor, err := decodeCursor(cursorToken)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
+	params := &repository.GetCasesByClientIDParams{
 		ClientID: clientID,
-		Limit:    int32(pageSize),
+		Limit:    int32(pageSize + 1),
 		Offset:   int32(offset),
-	})
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get cases: %w", err)
+	}
+	if cursor != nil {
+		params.Offset = 0
+		params.Column4 = true
+		params.Column5 = cursor.CreatedAt
+		params.Column6 = cursor.ID
 	}
 
-	total, err := s.repo.CountCasesByClientID(ctx, clientID)
+	cases, err := s.repo.GetCasesByClientID(ctx, params)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to count cases: %w", err)
+		return nil, 0, "", fmt.Errorf("failed to get cases: %w", err)
+	}
+
+	var nextCursor string
+	if len(cases) > pageSize {
+		cases = cases[:pageSize]
+		last := cases[len(cases)-1]
+		nextCursor = encodeCursor(pageCursor{CreatedAt: utils.PgtypeTimeToTime(last.CreatedAt), ID: last.ID})
+	}
+
+	var total int64
+	if cursor == nil {
+		total, err = s.repo.CountCasesByClientID(ctx, clientID)
+		if err != nil {
+			return nil, 0, "", fmt.Errorf("failed to count cases: %w", err)
+		}
 	}
 
 	result := make([]dto.CaseWithQuotesResponse, 0, len(cases))
@@ -74,21 +168,23 @@ func (s *CaseService) GetCasesByClientID(ctx context.Context, clientID uuid.UUID
 		
 		caseResp := dto.CaseWithQuotesResponse{
 			CaseResponse: dto.CaseResponse{
-				ID:          caseRecord.ID,
-				ClientID:    caseRecord.ClientID,
-				Title:       caseRecord.Title,
-				Category:    caseRecord.Category,
-				Description: caseRecord.Description,
-				Status:      caseRecord.Status,
-				CreatedAt:   utils.PgtypeTimeToTime(caseRecord.CreatedAt),
-				UpdatedAt:   utils.PgtypeTimeToTime(caseRecord.UpdatedAt),
+				ID:           caseRecord.ID,
+				ClientID:     caseRecord.ClientID,
+				Title:        caseRecord.Title,
+				Category:     caseRecord.Category,
+				Description:  caseRecord.Description,
+				Jurisdiction: utils.GetNullableString(caseRecord.Jurisdiction),
+				Deadline:     nullableTime(caseRecord.Deadline),
+				Status:       caseRecord.Status,
+				CreatedAt:    utils.PgtypeTimeToTime(caseRecord.CreatedAt),
+				UpdatedAt:    utils.PgtypeTimeToTime(caseRecord.UpdatedAt),
 			},
 			QuotesCount: int(quotesCount),
 		}
 		result = append(result, caseResp)
 	}
 
-	return result, int64(total), nil
+	return result, total, nextCursor, nil
 }
 
 func getDecimalOrZero(d *decimal.Decimal) decimal.Decimal {
@@ -98,6 +194,21 @@ func getDecimalOrZero(d *decimal.Decimal) decimal.Decimal {
 	return *d
 }
 
+func nullableTime(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func nullableInt(i pgtype.Int4) *int {
+	if !i.Valid {
+		return nil
+	}
+	value := int(i.Int32)
+	return &value
+}
+
 
 func (s *CaseService) GetCaseByID(ctx context.Context, caseID uuid.UUID, userID uuid.UUID, userRole string) (*dto.CaseWithQuotesResponse, error) {
 	caseRecord, err := s.repo.GetCaseByID(ctx, caseID)
@@ -116,25 +227,111 @@ func (s *CaseService) GetCaseByID(ctx context.Context, caseID uuid.UUID, userID
 		return nil, fmt.Errorf("failed to get quotes: %w", err)
 	}
 
+	quoteRevisions, err := s.repo.GetQuoteRevisionsByCaseID(ctx, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quote revisions: %w", err)
+	}
+	revisionsByQuote := map[uuid.UUID][]dto.QuoteRevisionResponse{}
+	for _, revision := range quoteRevisions {
+		revisionsByQuote[revision.QuoteID] = append(revisionsByQuote[revision.QuoteID], dto.QuoteRevisionResponse{
+			Revision:     int(revision.Revision),
+			Amount:       getDecimalOrZero(utils.PgtypeNumericToDecimal(revision.Amount)),
+			ExpectedDays: int(revision.ExpectedDays),
+			Note:         utils.GetStringOrEmpty(utils.GetNullableString(revision.Note)),
+			Pricing: quotePricingToResponse(quotePricingColumns{
+				PricingModel:      revision.PricingModel,
+				Amount:            revision.Amount,
+				HourlyRate:        revision.HourlyRate,
+				EstimatedHoursMin: revision.EstimatedHoursMin,
+				EstimatedHoursMax: revision.EstimatedHoursMax,
+				CapAmount:         revision.CapAmount,
+				DepositAmount:     revision.DepositAmount,
+			}),
+			Milestones: revisionMilestonesToResponse(revision.Milestones),
+			RevisedAt:  utils.PgtypeTimeToTime(revision.CreatedAt),
+		})
+	}
+
+	quoteMilestones, err := s.repo.GetQuoteMilestonesByCaseID(ctx, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quote milestones: %w", err)
+	}
+	milestonesByQuote := map[uuid.UUID][]*repository.QuoteMilestone{}
+	for _, milestone := range quoteMilestones {
+		milestonesByQuote[milestone.QuoteID] = append(milestonesByQuote[milestone.QuoteID], milestone)
+	}
+
+	var lastViewedAt *time.Time
+	view, err := s.repo.GetCaseView(ctx, &repository.GetCaseViewParams{CaseID: caseID, UserID: userID})
+	if err == nil {
+		lastViewedAt = &view.ViewedAt
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get case view: %w", err)
+	}
+
 	quotesResp := make([]dto.QuoteResponse, 0, len(quotes))
 	for _, quote := range quotes {
+		// Revisions are newest first, so the first one is the latest edit.
+		revisions := revisionsByQuote[quote.ID]
+		changedSinceViewed := lastViewedAt != nil && len(revisions) > 0 && revisions[0].RevisedAt.After(*lastViewedAt)
+
+		pricing := quotePricingToResponse(quotePricingColumns{
+			PricingModel:      quote.PricingModel,
+			Amount:            quote.Amount,
+			AgreedAmount:      quote.AgreedAmount,
+			HourlyRate:        quote.HourlyRate,
+			EstimatedHoursMin: quote.EstimatedHoursMin,
+			EstimatedHoursMax: quote.EstimatedHoursMax,
+			CapAmount:         quote.CapAmount,
+			DepositAmount:     quote.DepositAmount,
+		})
+
 		quotesResp = append(quotesResp, dto.QuoteResponse{
-			ID:           quote.ID,
-			CaseID:       quote.CaseID,
-			LawyerID:     quote.LawyerID,
-			Amount:       getDecimalOrZero(utils.PgtypeNumericToDecimal(quote.Amount)),
-			ExpectedDays: int(quote.ExpectedDays),
-			Note:         utils.GetStringOrEmpty(utils.GetNullableString(quote.Note)),
-			Status:       quote.Status,
-			CreatedAt:    utils.PgtypeTimeToTime(quote.CreatedAt),
-			UpdatedAt:    utils.PgtypeTimeToTime(quote.UpdatedAt),
-			LawyerName:   utils.GetNullableString(quote.LawyerName),
+			ID:                 quote.ID,
+			CaseID:             quote.CaseID,
+			LawyerID:           quote.LawyerID,
+			Amount:             getDecimalOrZero(utils.PgtypeNumericToDecimal(quote.Amount)),
+			ExpectedDays:       int(quote.ExpectedDays),
+			Note:               utils.GetStringOrEmpty(utils.GetNullableString(quote.Note)),
+			Status:             quote.Status,
+			CreatedAt:          utils.PgtypeTimeToTime(quote.CreatedAt),
+			UpdatedAt:          utils.PgtypeTimeToTime(quote.UpdatedAt),
+			ValidUntil:         nullableTime(quote.ValidUntil),
+			DeclineReason:      utils.GetNullableString(quote.DeclineReason),
+			RebidAllowed:       quote.RebidAllowed,
+			AgreedAmount:       utils.PgtypeNumericToDecimal(quote.AgreedAmount),
+			AgreedDays:         nullableInt(quote.AgreedDays),
+			Pricing:            pricing,
+			LawyerName:         utils.GetNullableString(quote.LawyerName),
+			Milestones:         quoteMilestonesToResponse(milestonesByQuote[quote.ID]),
+			Revisions:          revisions,
+			ChangedSinceViewed: changedSinceViewed,
 		})
 	}
 
 
+	history, err := s.repo.GetCaseStatusHistory(ctx, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get case timeline: %w", err)
+	}
+
+	revisions, err := s.repo.GetCaseRevisions(ctx, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get case revisions: %w", err)
+	}
+
+
+	var redactions []dto.CaseRedactionResponse
+	if userRole == "client" {
+		caseRedactions, err := s.repo.GetCaseRedactions(ctx, caseID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get case redactions: %w", err)
+		}
+		redactions = caseRedactionsToResponse(caseRecord.Description, caseRedactions)
+	}
+
 	files := []dto.FileResponse{}
-	if userRole == "client" || (userRole == "lawyer" && caseRecord.Status == "engaged") {
+	if userRole == "client" || (userRole == "lawyer" && lifecycle.SharesDetailsWithLawyer(caseRecord.Status)) {
 		caseFiles, _ := s.repo.GetCaseFilesByCaseID(ctx, caseID)
 		for _, file := range caseFiles {
 			files = append(files, dto.FileResponse{
@@ -147,20 +344,480 @@ func (s *CaseService) GetCaseByID(ctx context.Context, caseID uuid.UUID, userID
 		}
 	}
 
+	if err := s.repo.RecordCaseView(ctx, &repository.RecordCaseViewParams{CaseID: caseID, UserID: userID}); err != nil {
+		log.Printf("Failed to record case view: %v", err)
+	}
+
 	return &dto.CaseWithQuotesResponse{
 		CaseResponse: dto.CaseResponse{
-			ID:          caseRecord.ID,
-			ClientID:    caseRecord.ClientID,
-			Title:       caseRecord.Title,
-			Category:    caseRecord.Category,
-			Description: caseRecord.Description,
-			Status:      caseRecord.Status,
-			CreatedAt:   utils.PgtypeTimeToTime(caseRecord.CreatedAt),
-			UpdatedAt:   utils.PgtypeTimeToTime(caseRecord.UpdatedAt),
+			ID:           caseRecord.ID,
+			ClientID:     caseRecord.ClientID,
+			Title:        caseRecord.Title,
+			Category:     caseRecord.Category,
+			Description:  caseRecord.Description,
+			Jurisdiction: utils.GetNullableString(caseRecord.Jurisdiction),
+			Deadline:     nullableTime(caseRecord.Deadline),
+			Status:       caseRecord.Status,
+			CreatedAt:    utils.PgtypeTimeToTime(caseRecord.CreatedAt),
+			UpdatedAt:    utils.PgtypeTimeToTime(caseRecord.UpdatedAt),
 		},
 		QuotesCount: len(quotesResp),
 		Quotes:      quotesResp,
 		Files:       files,
+		Timeline:    caseTimeline(history),
+		Revisions:   caseRevisionsToResponse(revisions),
+		Redactions:  redactions,
 	}, nil
 }
 
+// UpdateCase replaces the title, category and description of an open case.
+// The previous content is kept as a revision, and lawyers with a proposed
+// quote are told the case changed.
+func (s *CaseService) UpdateCase(ctx context.Context, caseID, clientID uuid.UUID, req dto.UpdateCaseRequest) (*dto.CaseResponse, error) {
+	caseRecord, err := s.getOwnedCase(ctx, caseID, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !lifecycle.IsEditable(caseRecord.Status) {
+		return nil, fmt.Errorf("only open cases can be edited")
+	}
+
+	if len(caseChangedFields(caseRecord, req)) == 0 {
+		return caseToResponse(caseRecord), nil
+	}
+
+	client, err := s.repo.GetUserByID(ctx, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+	subject := redactionSubject(caseRecord.Jurisdiction, client.Name, client.Email)
+	subject.Phrases, err = manualRedactionPhrases(ctx, s.repo, caseID)
+	if err != nil {
+		return nil, err
+	}
+	redacted := s.redactor.Redact(req.Description, subject)
+
+	var changedFields []string
+	var revision *repository.CaseRevision
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		// The locked row is what this edit replaces, so concurrent edits
+		// snapshot and number their revisions one after the other.
+		previous, err := txRepo.GetCaseByIDForUpdate(ctx, caseID)
+		if err != nil {
+			return fmt.Errorf("failed to get case: %w", err)
+		}
+		if !lifecycle.IsEditable(previous.Status) {
+			return fmt.Errorf("only open cases can be edited")
+		}
+
+		changedFields = caseChangedFields(previous, req)
+		if len(changedFields) == 0 {
+			caseRecord = previous
+			return nil
+		}
+		if previous.Category != req.Category {
+			if err := validateCategory(ctx, txRepo, req.Category); err != nil {
+				return err
+			}
+		}
+
+		latest, err := txRepo.GetLatestCaseRevisionNumber(ctx, caseID)
+		if err != nil {
+			return fmt.Errorf("failed to get latest revision: %w", err)
+		}
+
+		caseRecord, err = txRepo.UpdateCaseDetails(ctx, &repository.UpdateCaseDetailsParams{
+			ID:                  caseID,
+			Title:               req.Title,
+			Category:            req.Category,
+			Description:         req.Description,
+			RedactedDescription: redacted.Text,
+			RedactionVersion:    caseRedactionVersion,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("only open cases can be edited")
+			}
+			return fmt.Errorf("failed to update case: %w", err)
+		}
+
+		if err := saveCaseRedactions(ctx, txRepo, caseID, redacted.Matches); err != nil {
+			return err
+		}
+
+		// Lawyers keep seeing the revision as it was redacted when it was
+		// replaced, whatever the client marks for redaction later.
+		previousRedacted := previous.RedactedDescription
+		if !isRedactionCurrent(previous.RedactedAt, previous.RedactionVersion) {
+			previousRedacted = s.redactor.Redact(previous.Description, subject).Text
+		}
+
+		revision, err = txRepo.CreateCaseRevision(ctx, &repository.CreateCaseRevisionParams{
+			CaseID:              caseID,
+			Revision:            latest + 1,
+			Title:               previous.Title,
+			Category:            previous.Category,
+			Description:         previous.Description,
+			ChangedFields:       changedFields,
+			EditedBy:            utils.UUIDToPgtypeUUID(&clientID),
+			RedactedDescription: pgtype.Text{String: previousRedacted, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save case revision: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if revision == nil {
+		return caseToResponse(caseRecord), nil
+	}
+
+	quotes, err := s.repo.GetQuotesByCaseID(ctx, caseID)
+	if err != nil {
+		log.Printf("Failed to load quotes for case-updated notification: %v", err)
+	}
+	for _, quote := range quotes {
+		if quote.Status != "proposed" {
+			continue
+		}
+		notifyUser(s.pusherClient, quote.LawyerID, "case-updated", map[string]interface{}{
+			"case_id":        caseID.String(),
+			"case_title":     caseRecord.Title,
+			"revision":       revision.Revision,
+			"changed_fields": changedFields,
+		})
+	}
+
+	return caseToResponse(caseRecord), nil
+}
+
+// caseChangedFields lists the fields an edit changes on a case.
+func caseChangedFields(caseRecord *repository.Case, req dto.UpdateCaseRequest) []string {
+	changedFields := []string{}
+	if req.Title != caseRecord.Title {
+		changedFields = append(changedFields, "title")
+	}
+	if req.Category != caseRecord.Category {
+		changedFields = append(changedFields, "category")
+	}
+	if req.Description != caseRecord.Description {
+		changedFields = append(changedFields, "description")
+	}
+	return changedFields
+}
+
+// GetMarketplacePreview shows the client their case as lawyers see it in the
+// marketplace, along with every span that was hidden.
+func (s *CaseService) GetMarketplacePreview(ctx context.Context, caseID, clientID uuid.UUID) (*dto.MarketplacePreviewResponse, error) {
+	caseRecord, err := s.getOwnedCase(ctx, caseID, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isRedactionCurrent(caseRecord.RedactedAt, caseRecord.RedactionVersion) {
+		err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+			caseRecord, err = s.redactCase(ctx, s.repo.WithTx(tx), caseRecord)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	redactions, err := s.repo.GetCaseRedactions(ctx, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get case redactions: %w", err)
+	}
+
+	return &dto.MarketplacePreviewResponse{
+		MarketplaceCaseResponse: dto.MarketplaceCaseResponse{
+			ID:           caseRecord.ID,
+			Title:        caseRecord.Title,
+			Category:     caseRecord.Category,
+			Description:  caseRecord.RedactedDescription,
+			Jurisdiction: utils.GetNullableString(caseRecord.Jurisdiction),
+			Deadline:     nullableTime(caseRecord.Deadline),
+			CreatedAt:    utils.PgtypeTimeToTime(caseRecord.CreatedAt),
+			Status:       caseRecord.Status,
+		},
+		Redactions: caseRedactionsToResponse(caseRecord.Description, redactions),
+	}, nil
+}
+
+// UpdateRedactions replaces the parts of the description the client hides
+// from the marketplace themselves. Spans are only accepted while the case is
+// open.
+func (s *CaseService) UpdateRedactions(ctx context.Context, caseID, clientID uuid.UUID, spans []dto.RedactionSpan) (*dto.MarketplacePreviewResponse, error) {
+	caseRecord, err := s.getOwnedCase(ctx, caseID, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !lifecycle.IsEditable(caseRecord.Status) {
+		return nil, fmt.Errorf("redactions can only be changed while the case is open")
+	}
+
+	phrases, err := spansToPhrases(caseRecord.Description, spans)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		if err := saveManualRedactions(ctx, txRepo, caseID, phrases); err != nil {
+			return err
+		}
+
+		_, err := s.redactCase(ctx, txRepo, caseRecord)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetMarketplacePreview(ctx, caseID, clientID)
+}
+
+// redactCase recomputes and stores the marketplace description of a case from
+// its current description and the client's manual redactions.
+func (s *CaseService) redactCase(ctx context.Context, repo repository.Querier, caseRecord *repository.Case) (*repository.Case, error) {
+	client, err := repo.GetUserByID(ctx, caseRecord.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	subject := redactionSubject(caseRecord.Jurisdiction, client.Name, client.Email)
+	subject.Phrases, err = manualRedactionPhrases(ctx, repo, caseRecord.ID)
+	if err != nil {
+		return nil, err
+	}
+	redacted := s.redactor.Redact(caseRecord.Description, subject)
+
+	updated, err := repo.UpdateCaseRedactedDescription(ctx, &repository.UpdateCaseRedactedDescriptionParams{
+		ID:                  caseRecord.ID,
+		RedactedDescription: redacted.Text,
+		RedactionVersion:    caseRedactionVersion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save redacted description: %w", err)
+	}
+
+	if err := saveCaseRedactions(ctx, repo, caseRecord.ID, redacted.Matches); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// RedactStaleCases redacts again every case whose stored marketplace
+// description predates caseRedactionVersion, in batches that each commit on
+// their own, so search, snippets and saved searches see the current
+// detectors. It returns how many cases were redacted.
+func (s *CaseService) RedactStaleCases(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		var batch int
+		err := dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+			txRepo := s.repo.WithTx(tx)
+
+			cases, err := txRepo.ListCasesWithStaleRedaction(ctx, &repository.ListCasesWithStaleRedactionParams{
+				RedactionVersion: caseRedactionVersion,
+				Limit:            caseRedactionBatchSize,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list cases to redact: %w", err)
+			}
+
+			for _, caseRecord := range cases {
+				if _, err := s.redactCase(ctx, txRepo, caseRecord); err != nil {
+					return err
+				}
+			}
+			batch = len(cases)
+			return nil
+		})
+		if err != nil {
+			return total, err
+		}
+
+		total += batch
+		if batch < caseRedactionBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// RunRedactionBackfill runs RedactStaleCases once and logs the outcome.
+func (s *CaseService) RunRedactionBackfill(ctx context.Context) {
+	redacted, err := s.RedactStaleCases(ctx)
+	if err != nil {
+		log.Printf("Case redaction backfill failed after %d cases: %v", redacted, err)
+	} else if redacted > 0 {
+		log.Printf("Redacted %d cases with the current detectors", redacted)
+	}
+}
+
+// CancelCase withdraws an open case from the marketplace. Proposed quotes are
+// rejected and unpaid payment links deactivated; every lawyer who quoted is
+// notified.
+func (s *CaseService) CancelCase(ctx context.Context, caseID, clientID uuid.UUID) (*dto.CaseResponse, error) {
+	caseRecord, err := s.getOwnedCase(ctx, caseID, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rejectedQuotes []*repository.Quote
+	var cancelledPayments []*repository.Payment
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		caseRecord, err = transitionCase(ctx, txRepo, caseID, lifecycle.CaseCancelled, caseActor{ID: &clientID, Role: lifecycle.ActorClient}, "")
+		if err != nil {
+			return err
+		}
+
+		rejectedQuotes, err = txRepo.RejectProposedQuotesByCaseID(ctx, caseID)
+		if err != nil {
+			return fmt.Errorf("failed to reject quotes: %w", err)
+		}
+
+		cancelledPayments, err = txRepo.CancelPendingPaymentsByCaseID(ctx, caseID)
+		if err != nil {
+			return fmt.Errorf("failed to cancel pending payments: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deactivatePaymentLinks(cancelledPayments)
+
+	for _, quote := range rejectedQuotes {
+		notifyUser(s.pusherClient, quote.LawyerID, "case-cancelled", map[string]interface{}{
+			"case_id":      caseID.String(),
+			"case_title":   caseRecord.Title,
+			"quote_id":     quote.ID.String(),
+			"quote_status": quote.Status,
+		})
+	}
+
+	return caseToResponse(caseRecord), nil
+}
+
+// CloseCase marks an engaged case as finished and lets the engaged lawyer
+// know.
+func (s *CaseService) CloseCase(ctx context.Context, caseID, clientID uuid.UUID) (*dto.CaseResponse, error) {
+	caseRecord, err := s.getOwnedCase(ctx, caseID, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	var acceptedQuote *repository.Quote
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		caseRecord, err = transitionCase(ctx, txRepo, caseID, lifecycle.CaseClosed, caseActor{ID: &clientID, Role: lifecycle.ActorClient}, "")
+		if err != nil {
+			return err
+		}
+
+		acceptedQuote, err = txRepo.GetAcceptedQuoteByCaseID(ctx, caseID)
+		if err != nil {
+			return fmt.Errorf("failed to get accepted quote: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifyUser(s.pusherClient, acceptedQuote.LawyerID, "case-closed", map[string]interface{}{
+		"case_id":    caseID.String(),
+		"case_title": caseRecord.Title,
+		"quote_id":   acceptedQuote.ID.String(),
+	})
+
+	return caseToResponse(caseRecord), nil
+}
+
+// ReviewCase records the client's rating of the lawyer who handled a closed
+// case. Each case can be reviewed once.
+func (s *CaseService) ReviewCase(ctx context.Context, caseID, clientID uuid.UUID, req dto.ReviewCaseRequest) (*dto.CaseReviewResponse, error) {
+	caseRecord, err := s.getOwnedCase(ctx, caseID, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if caseRecord.Status != lifecycle.CaseClosed {
+		return nil, fmt.Errorf("only closed cases can be reviewed")
+	}
+
+	acceptedQuote, err := s.repo.GetAcceptedQuoteByCaseID(ctx, caseID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("this case was never engaged with a lawyer")
+		}
+		return nil, fmt.Errorf("failed to get accepted quote: %w", err)
+	}
+
+	_, err = s.repo.GetCaseReviewByCaseID(ctx, caseID)
+	if err == nil {
+		return nil, fmt.Errorf("you have already reviewed this case")
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to check existing review: %w", err)
+	}
+
+	review, err := s.repo.CreateCaseReview(ctx, &repository.CreateCaseReviewParams{
+		CaseID:   caseID,
+		LawyerID: acceptedQuote.LawyerID,
+		ClientID: clientID,
+		Rating:   int32(req.Rating),
+		Comment:  utils.ToPgtypeText(optionalString(req.Comment)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save review: %w", err)
+	}
+
+	return &dto.CaseReviewResponse{
+		ID:        review.ID,
+		CaseID:    review.CaseID,
+		LawyerID:  review.LawyerID,
+		Rating:    int(review.Rating),
+		Comment:   utils.GetNullableString(review.Comment),
+		CreatedAt: utils.PgtypeTimeToTime(review.CreatedAt),
+	}, nil
+}
+
+func (s *CaseService) getOwnedCase(ctx context.Context, caseID, clientID uuid.UUID) (*repository.Case, error) {
+	caseRecord, err := s.repo.GetCaseByID(ctx, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("case not found: %w", err)
+	}
+	if caseRecord.ClientID != clientID {
+		return nil, fmt.Errorf("unauthorized: you can only manage your own cases")
+	}
+	return caseRecord, nil
+}
+
+func caseRevisionsToResponse(revisions []*repository.CaseRevision) []dto.CaseRevisionResponse {
+	result := make([]dto.CaseRevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		result = append(result, dto.CaseRevisionResponse{
+			Revision:      int(revision.Revision),
+			Title:         revision.Title,
+			Category:      revision.Category,
+			Description:   revision.Description,
+			ChangedFields: revision.ChangedFields,
+			EditedAt:      utils.PgtypeTimeToTime(revision.CreatedAt),
+		})
+	}
+	return result
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-modules/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+type CategoryService struct {
+	repo repository.Repository
+}
+
+func NewCategoryService(repo repository.Repository) *CategoryService {
+	return &CategoryService{
+		repo: repo,
+	}
+}
+
+func (s *CategoryService) ListCategories(ctx context.Context) ([]dto.CategoryResponse, error) {
+	categories, err := s.repo.ListActiveCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	result := make([]dto.CategoryResponse, 0, len(categories))
+	for _, category := range categories {
+		result = append(result, dto.CategoryResponse{
+			Slug:       category.Slug,
+			Name:       category.Name,
+			ParentSlug: utils.GetNullableString(category.ParentSlug),
+		})
+	}
+
+	return result, nil
+}
+
+// validateCategory checks that slug names an active category, so cases can
+// only be filed under the managed taxonomy.
+func validateCategory(ctx context.Context, repo repository.Querier, slug string) error {
+	category, err := repo.GetCategoryBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("unknown category %q, see GET /api/v1/categories", slug)
+		}
+		return fmt.Errorf("failed to get category: %w", err)
+	}
+	if !category.IsActive {
+		return fmt.Errorf("category %q is no longer available", slug)
+	}
+	return nil
+}
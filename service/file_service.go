@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/gadhittana01/cases-app-server/db/repository"
 	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/lifecycle"
 	"github.com/gadhittana01/cases-modules/utils"
 	"github.com/google/uuid"
 )
@@ -36,14 +37,8 @@ func NewFileService(repo repository.Repository, s3Client *s3.Client, presignClie
 }
 
 func (s *FileService) UploadCaseFile(ctx context.Context, caseID uuid.UUID, fileHeader *multipart.FileHeader) (*dto.FileResponse, error) {
-	ext := strings.ToLower(filepath.Ext(fileHeader.Filename))
-	if ext != ".pdf" && ext != ".png" {
-		return nil, fmt.Errorf("only PDF and PNG files are allowed")
-	}
-
-	const maxSize = 10 * 1024 * 1024
-	if fileHeader.Size > maxSize {
-		return nil, fmt.Errorf("file size exceeds 10MB limit")
+	if err := validateUploadedFile(fileHeader); err != nil {
+		return nil, err
 	}
 
 	count, err := s.repo.CountCaseFilesByCaseID(ctx, caseID)
@@ -54,26 +49,10 @@ func (s *FileService) UploadCaseFile(ctx context.Context, caseID uuid.UUID, file
 		return nil, fmt.Errorf("maximum 10 files allowed per case")
 	}
 
-	file, err := fileHeader.Open()
-	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %w", err)
-	}
-	defer file.Close()
-
-	secureFilename := generateSecureFilename(fileHeader.Filename)
-	filePath := fmt.Sprintf("cases/%s/%s", caseID.String(), secureFilename)
-
-	contentType := fileHeader.Header.Get("Content-Type")
-
-	_, err = s.s3Client.PutObject(ctx, &s3.PutObjectInput{
-		Bucket:      aws.String(s.config.StorageBucket),
-		Key:         aws.String(filePath),
-		Body:        file,
-		ContentType: aws.String(contentType),
-		ACL:         types.ObjectCannedACLPrivate,
-	})
+	filePath := fmt.Sprintf("cases/%s/%s", caseID.String(), generateSecureFilename(fileHeader.Filename))
+	contentType, err := s.putObject(ctx, filePath, fileHeader)
 	if err != nil {
-		return nil, fmt.Errorf("failed to upload file to storage: %w", err)
+		return nil, err
 	}
 
 	fileRecord, err := s.repo.CreateCaseFile(ctx, &repository.CreateCaseFileParams{
@@ -121,16 +100,88 @@ func (s *FileService) GenerateDownloadURL(ctx context.Context, fileID uuid.UUID,
 		if acceptedQuote.LawyerID != userID {
 			return "", fmt.Errorf("unauthorized: you can only access files for cases where your quote was accepted")
 		}
-		if caseRecord.Status != "engaged" {
+		if !lifecycle.SharesDetailsWithLawyer(caseRecord.Status) {
 			return "", fmt.Errorf("unauthorized: case must be engaged to access files")
 		}
 	} else {
 		return "", fmt.Errorf("unauthorized")
 	}
 
+	return s.presignFilePath(ctx, fileRecord.FilePath)
+}
+
+func (s *FileService) UploadVerificationDocument(ctx context.Context, verificationID uuid.UUID, fileHeader *multipart.FileHeader) (*dto.FileResponse, error) {
+	if err := validateUploadedFile(fileHeader); err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountLawyerVerificationDocuments(ctx, verificationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check document count: %w", err)
+	}
+	if count >= 5 {
+		return nil, fmt.Errorf("maximum 5 documents allowed per verification")
+	}
+
+	filePath := fmt.Sprintf("lawyer-verifications/%s/%s", verificationID.String(), generateSecureFilename(fileHeader.Filename))
+	contentType, err := s.putObject(ctx, filePath, fileHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := s.repo.CreateLawyerVerificationDocument(ctx, &repository.CreateLawyerVerificationDocumentParams{
+		VerificationID: verificationID,
+		FileName:       fileHeader.Filename,
+		FilePath:       filePath,
+		FileSize:       fileHeader.Size,
+		MimeType:       contentType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save document record: %w", err)
+	}
+
+	return &dto.FileResponse{
+		ID:        document.ID,
+		FileName:  document.FileName,
+		FileSize:  document.FileSize,
+		MimeType:  document.MimeType,
+		CreatedAt: utils.PgtypeTimeToTime(document.CreatedAt),
+	}, nil
+}
+
+// GenerateVerificationDocumentURL returns a signed URL for a credential
+// document. Callers are responsible for checking the requester may see it.
+func (s *FileService) GenerateVerificationDocumentURL(ctx context.Context, document *repository.LawyerVerificationDocument) (string, error) {
+	return s.presignFilePath(ctx, document.FilePath)
+}
+
+func (s *FileService) putObject(ctx context.Context, filePath string, fileHeader *multipart.FileHeader) (string, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer file.Close()
+
+	contentType := fileHeader.Header.Get("Content-Type")
+
+	_, err = s.s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.config.StorageBucket),
+		Key:         aws.String(filePath),
+		Body:        file,
+		ContentType: aws.String(contentType),
+		ACL:         types.ObjectCannedACLPrivate,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to upload file to storage: %w", err)
+	}
+
+	return contentType, nil
+}
+
+func (s *FileService) presignFilePath(ctx context.Context, filePath string) (string, error) {
 	request, err := s.presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(s.config.StorageBucket),
-		Key:    aws.String(fileRecord.FilePath),
+		Key:    aws.String(filePath),
 	}, func(opts *s3.PresignOptions) {
 		opts.Expires = time.Duration(1 * time.Hour)
 	})
@@ -141,6 +192,20 @@ func (s *FileService) GenerateDownloadURL(ctx context.Context, fileID uuid.UUID,
 	return request.URL, nil
 }
 
+func validateUploadedFile(fileHeader *multipart.FileHeader) error {
+	ext := strings.ToLower(filepath.Ext(fileHeader.Filename))
+	if ext != ".pdf" && ext != ".png" {
+		return fmt.Errorf("only PDF and PNG files are allowed")
+	}
+
+	const maxSize = 10 * 1024 * 1024
+	if fileHeader.Size > maxSize {
+		return fmt.Errorf("file size exceeds 10MB limit")
+	}
+
+	return nil
+}
+
 func generateSecureFilename(originalFilename string) string {
 
 	b := make([]byte, 16)
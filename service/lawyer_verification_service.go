@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-modules/utils"
+	dbUtils "github.com/gadhittana01/cases-modules/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	VerificationStatusPending  = "pending"
+	VerificationStatusApproved = "approved"
+	VerificationStatusRejected = "rejected"
+)
+
+type LawyerVerificationService struct {
+	repo        repository.Repository
+	fileService *FileService
+}
+
+func NewLawyerVerificationService(repo repository.Repository, fileService *FileService) *LawyerVerificationService {
+	return &LawyerVerificationService{
+		repo:        repo,
+		fileService: fileService,
+	}
+}
+
+func (s *LawyerVerificationService) GetMyVerification(ctx context.Context, lawyerID uuid.UUID) (*dto.LawyerVerificationResponse, error) {
+	verification, err := s.repo.GetLatestLawyerVerificationByLawyerID(ctx, lawyerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("no verification request found")
+		}
+		return nil, fmt.Errorf("failed to get verification: %w", err)
+	}
+
+	response := verificationToResponse(verification)
+	documents, err := s.documentsToResponse(ctx, verification.ID, false)
+	if err != nil {
+		return nil, err
+	}
+	response.Documents = documents
+
+	return response, nil
+}
+
+// SubmitVerification opens a new verification request, e.g. after a
+// rejection. Only one pending request may exist per lawyer.
+func (s *LawyerVerificationService) SubmitVerification(ctx context.Context, lawyerID uuid.UUID, req dto.SubmitVerificationRequest) (*dto.LawyerVerificationResponse, error) {
+	latest, err := s.repo.GetLatestLawyerVerificationByLawyerID(ctx, lawyerID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get verification: %w", err)
+	}
+	if err == nil && latest.Status == VerificationStatusPending {
+		return nil, fmt.Errorf("a verification request is already pending review")
+	}
+	if err == nil && latest.Status == VerificationStatusApproved {
+		return nil, fmt.Errorf("you are already verified")
+	}
+
+	verification, err := s.repo.CreateLawyerVerification(ctx, &repository.CreateLawyerVerificationParams{
+		LawyerID:     lawyerID,
+		Jurisdiction: utils.ToPgtypeText(&req.Jurisdiction),
+		BarNumber:    utils.ToPgtypeText(&req.BarNumber),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create verification: %w", err)
+	}
+
+	return verificationToResponse(verification), nil
+}
+
+func (s *LawyerVerificationService) UploadDocument(ctx context.Context, lawyerID uuid.UUID, fileHeader *multipart.FileHeader) (*dto.FileResponse, error) {
+	verification, err := s.repo.GetLatestLawyerVerificationByLawyerID(ctx, lawyerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("no verification request found")
+		}
+		return nil, fmt.Errorf("failed to get verification: %w", err)
+	}
+	if verification.Status != VerificationStatusPending {
+		return nil, fmt.Errorf("documents can only be added to a pending verification request")
+	}
+
+	return s.fileService.UploadVerificationDocument(ctx, verification.ID, fileHeader)
+}
+
+func (s *LawyerVerificationService) ListVerifications(ctx context.Context, status string, page, pageSize int) ([]dto.LawyerVerificationResponse, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	offset := (page - 1) * pageSize
+
+	verifications, err := s.repo.ListLawyerVerifications(ctx, &repository.ListLawyerVerificationsParams{
+		Column1: status,
+		Limit:   int32(pageSize),
+		Offset:  int32(offset),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list verifications: %w", err)
+	}
+
+	total, err := s.repo.CountLawyerVerifications(ctx, status)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count verifications: %w", err)
+	}
+
+	result := make([]dto.LawyerVerificationResponse, 0, len(verifications))
+	for _, verification := range verifications {
+		result = append(result, *verificationRowToResponse(verification))
+	}
+
+	return result, total, nil
+}
+
+// GetVerification returns a verification request with signed download URLs
+// for its documents, for admin review.
+func (s *LawyerVerificationService) GetVerification(ctx context.Context, verificationID uuid.UUID) (*dto.LawyerVerificationResponse, error) {
+	verification, err := s.repo.GetLawyerVerificationByID(ctx, verificationID)
+	if err != nil {
+		return nil, fmt.Errorf("verification not found: %w", err)
+	}
+
+	response := verificationRowToResponse((*repository.ListLawyerVerificationsRow)(verification))
+	documents, err := s.documentsToResponse(ctx, verification.ID, true)
+	if err != nil {
+		return nil, err
+	}
+	response.Documents = documents
+
+	return response, nil
+}
+
+func (s *LawyerVerificationService) ReviewVerification(ctx context.Context, verificationID, adminID uuid.UUID, status, note string) (*dto.LawyerVerificationResponse, error) {
+	if status == VerificationStatusRejected && note == "" {
+		return nil, fmt.Errorf("a note is required when rejecting a verification")
+	}
+
+	var reviewNote *string
+	if note != "" {
+		reviewNote = &note
+	}
+
+	var verification *repository.LawyerVerification
+	err := dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		var err error
+		verification, err = txRepo.ReviewLawyerVerification(ctx, &repository.ReviewLawyerVerificationParams{
+			ID:         verificationID,
+			Status:     status,
+			ReviewedBy: utils.UUIDToPgtypeUUID(&adminID),
+			ReviewNote: utils.ToPgtypeText(reviewNote),
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("verification not found or already reviewed")
+			}
+			return fmt.Errorf("failed to review verification: %w", err)
+		}
+
+		if status != VerificationStatusApproved {
+			return nil
+		}
+
+		// The lawyer is verified for the credentials that were reviewed, which
+		// is what the marketplace and quoting read from the user.
+		if _, err := txRepo.UpdateUser(ctx, &repository.UpdateUserParams{
+			ID:           verification.LawyerID,
+			Jurisdiction: verification.Jurisdiction,
+			BarNumber:    verification.BarNumber,
+		}); err != nil {
+			return fmt.Errorf("failed to update lawyer credentials: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return verificationToResponse(verification), nil
+}
+
+func (s *LawyerVerificationService) documentsToResponse(ctx context.Context, verificationID uuid.UUID, withDownloadURL bool) ([]dto.FileResponse, error) {
+	documents, err := s.repo.GetLawyerVerificationDocuments(ctx, verificationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get documents: %w", err)
+	}
+
+	result := make([]dto.FileResponse, 0, len(documents))
+	for _, document := range documents {
+		file := dto.FileResponse{
+			ID:        document.ID,
+			FileName:  document.FileName,
+			FileSize:  document.FileSize,
+			MimeType:  document.MimeType,
+			CreatedAt: utils.PgtypeTimeToTime(document.CreatedAt),
+		}
+		if withDownloadURL {
+			url, err := s.fileService.GenerateVerificationDocumentURL(ctx, document)
+			if err != nil {
+				return nil, err
+			}
+			file.DownloadURL = &url
+		}
+		result = append(result, file)
+	}
+
+	return result, nil
+}
+
+// isLawyerVerified reports whether the lawyer's most recent verification
+// request has been approved.
+func isLawyerVerified(ctx context.Context, repo repository.Querier, lawyerID uuid.UUID) (bool, error) {
+	verification, err := repo.GetLatestLawyerVerificationByLawyerID(ctx, lawyerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get verification: %w", err)
+	}
+	return verification.Status == VerificationStatusApproved, nil
+}
+
+func verificationToResponse(verification *repository.LawyerVerification) *dto.LawyerVerificationResponse {
+	response := &dto.LawyerVerificationResponse{
+		ID:           verification.ID,
+		LawyerID:     verification.LawyerID,
+		Jurisdiction: utils.GetNullableString(verification.Jurisdiction),
+		BarNumber:    utils.GetNullableString(verification.BarNumber),
+		Status:       verification.Status,
+		ReviewNote:   utils.GetNullableString(verification.ReviewNote),
+		CreatedAt:    utils.PgtypeTimeToTime(verification.CreatedAt),
+		UpdatedAt:    utils.PgtypeTimeToTime(verification.UpdatedAt),
+	}
+	if verification.ReviewedAt.Valid {
+		response.ReviewedAt = &verification.ReviewedAt.Time
+	}
+	return response
+}
+
+func verificationRowToResponse(row *repository.ListLawyerVerificationsRow) *dto.LawyerVerificationResponse {
+	response := verificationToResponse(&repository.LawyerVerification{
+		ID:           row.ID,
+		LawyerID:     row.LawyerID,
+		Jurisdiction: row.Jurisdiction,
+		BarNumber:    row.BarNumber,
+		Status:       row.Status,
+		ReviewedBy:   row.ReviewedBy,
+		ReviewNote:   row.ReviewNote,
+		ReviewedAt:   row.ReviewedAt,
+		CreatedAt:    row.CreatedAt,
+		UpdatedAt:    row.UpdatedAt,
+	})
+	response.LawyerName = utils.GetNullableString(row.LawyerName)
+	response.LawyerEmail = &row.LawyerEmail
+	return response
+}
@@ -3,27 +3,56 @@ package service
 import (
 	"context"
 	"fmt"
-	"regexp"
+	"html"
 	"strings"
 	"time"
 
 	"github.com/gadhittana01/cases-app-server/db/repository"
 	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/lifecycle"
+	"github.com/gadhittana01/cases-app-server/redaction"
 	"github.com/gadhittana01/cases-modules/utils"
 	"github.com/google/uuid"
 )
 
+// jurisdictionAny is the marketplace jurisdiction filter value that shows
+// cases from every jurisdiction.
+const jurisdictionAny = "any"
+
+// Marketplace sort orders. The default lists search matches by relevance and
+// everything else newest first.
+const (
+	MarketplaceSortNewest           = "newest"
+	MarketplaceSortFewestQuotes     = "fewest_quotes"
+	MarketplaceSortOldestUnanswered = "oldest_unanswered"
+	MarketplaceSortDeadline         = "deadline"
+)
+
+var marketplaceSorts = map[string]bool{
+	"":                              true,
+	MarketplaceSortNewest:           true,
+	MarketplaceSortFewestQuotes:     true,
+	MarketplaceSortOldestUnanswered: true,
+	MarketplaceSortDeadline:         true,
+}
+
 type MarketplaceService struct {
-	repo repository.Repository
+	repo     repository.Repository
+	redactor *redaction.Redactor
 }
 
-func NewMarketplaceService(repo repository.Repository) *MarketplaceService {
+func NewMarketplaceService(repo repository.Repository, redactor *redaction.Redactor) *MarketplaceService {
 	return &MarketplaceService{
-		repo: repo,
+		repo:     repo,
+		redactor: redactor,
 	}
 }
 
-func (s *MarketplaceService) ListOpenCases(ctx context.Context, filters dto.MarketplaceFilters) ([]dto.MarketplaceCaseResponse, int64, error) {
+// ListOpenCases returns a page of open cases and, when more remain, the cursor
+// of the next page. The total is only counted for page/page_size requests.
+// Without a jurisdiction filter the lawyer only sees cases in their own
+// jurisdiction; "any" lifts the filter.
+func (s *MarketplaceService) ListOpenCases(ctx context.Context, lawyerID uuid.UUID, filters dto.MarketplaceFilters) ([]dto.MarketplaceCaseResponse, int64, string, error) {
 	page := filters.Page
 	if page < 1 {
 		page = 1
@@ -34,65 +63,166 @@ func (s *MarketplaceService) ListOpenCases(ctx context.Context, filters dto.Mark
 	}
 	offset := (page - 1) * pageSize
 
+	if !marketplaceSorts[filters.Sort] {
+		return nil, 0, "", fmt.Errorf("invalid sort %q, use newest, fewest_quotes, oldest_unanswered or deadline", filters.Sort)
+	}
+
+	minQuotes, maxQuotes := int32(-1), int32(-1)
+	if filters.MinQuotes != nil {
+		minQuotes = int32(*filters.MinQuotes)
+	}
+	if filters.MaxQuotes != nil {
+		maxQuotes = int32(*filters.MaxQuotes)
+	}
+	if minQuotes < -1 || maxQuotes < -1 || (maxQuotes >= 0 && minQuotes > maxQuotes) {
+		return nil, 0, "", fmt.Errorf("invalid quote count range")
+	}
+
+	cursor, err := decodeCursor(filters.Cursor)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if cursor != nil {
+		if cursor.Sort != filters.Sort {
+			return nil, 0, "", ErrInvalidCursor
+		}
+		offset = 0
+	}
+
 	categoryFilter := filters.Category
 
 	var createdSinceFilter time.Time
 	if filters.CreatedSince != "" {
 		parsedTime, err := time.Parse(time.RFC3339, filters.CreatedSince)
 		if err != nil {
-			return nil, 0, fmt.Errorf("invalid created_since format, use ISO 8601: %w", err)
+			return nil, 0, "", fmt.Errorf("invalid created_since format, use ISO 8601: %w", err)
 		}
 		createdSinceFilter = parsedTime
 	}
 
-	cases, err := s.repo.ListOpenCases(ctx, &repository.ListOpenCasesParams{
-		Column1: categoryFilter,
-		Column2: createdSinceFilter,
-		Limit:   int32(pageSize),
-		Offset:  int32(offset),
-	})
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list cases: %w", err)
+	query := strings.TrimSpace(filters.Query)
+
+	jurisdictionFilter := strings.TrimSpace(filters.Jurisdiction)
+	switch {
+	case strings.EqualFold(jurisdictionFilter, jurisdictionAny):
+		jurisdictionFilter = ""
+	case jurisdictionFilter == "":
+		lawyer, err := s.repo.GetUserByID(ctx, lawyerID)
+		if err != nil {
+			return nil, 0, "", fmt.Errorf("user not found: %w", err)
+		}
+		jurisdictionFilter = strings.TrimSpace(lawyer.Jurisdiction.String)
 	}
 
-	total, err := s.repo.CountOpenCases(ctx, &repository.CountOpenCasesParams{
-		Column1: categoryFilter,
-		Column2: createdSinceFilter,
-	})
+	// Text search and its snippets read the stored redaction, so cases still
+	// waiting for RedactStaleCases are left out of search results until they
+	// are redacted with the current detectors.
+	params := &repository.ListOpenCasesParams{
+		Column1:  categoryFilter,
+		Column2:  createdSinceFilter,
+		Limit:    int32(pageSize + 1),
+		Offset:   int32(offset),
+		Column5:  query,
+		Column10: jurisdictionFilter,
+		Column11: filters.Sort,
+		Column12: filters.NotQuotedByMe,
+		Column13: lawyerID,
+		Column14: minQuotes,
+		Column15: maxQuotes,
+		Column16: caseRedactionVersion,
+	}
+	if cursor != nil {
+		params.Column6 = true
+		params.Column7 = cursor.SortKey
+		params.Column8 = cursor.CreatedAt
+		params.Column9 = cursor.ID
+	}
+
+	cases, err := s.repo.ListOpenCases(ctx, params)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to count cases: %w", err)
+		return nil, 0, "", fmt.Errorf("failed to list cases: %w", err)
+	}
+
+	var nextCursor string
+	if len(cases) > pageSize {
+		cases = cases[:pageSize]
+		last := cases[len(cases)-1]
+		nextCursor = encodeCursor(pageCursor{
+			CreatedAt: utils.PgtypeTimeToTime(last.CreatedAt),
+			ID:        last.ID,
+			Sort:      filters.Sort,
+			SortKey:   last.SortKey,
+		})
+	}
+
+	var total int64
+	if cursor == nil {
+		total, err = s.repo.CountOpenCases(ctx, &repository.CountOpenCasesParams{
+			Column1: categoryFilter,
+			Column2: createdSinceFilter,
+			Column3: query,
+			Column4: jurisdictionFilter,
+			Column5: filters.NotQuotedByMe,
+			Column6: lawyerID,
+			Column7: minQuotes,
+			Column8: maxQuotes,
+			Column9: caseRedactionVersion,
+		})
+		if err != nil {
+			return nil, 0, "", fmt.Errorf("failed to count cases: %w", err)
+		}
 	}
 
 	result := make([]dto.MarketplaceCaseResponse, 0, len(cases))
 	for _, caseRecord := range cases {
+		description, err := marketplaceDescription(ctx, s.repo, s.redactor, caseRecord.ID, caseRecord.Description, caseRecord.RedactedDescription,
+			caseRecord.RedactedAt, caseRecord.RedactionVersion, redactionSubject(caseRecord.Jurisdiction, caseRecord.ClientName, caseRecord.ClientEmail))
+		if err != nil {
+			return nil, 0, "", err
+		}
 
-		description := anonymizeDescription(caseRecord.Description)
-
-		result = append(result, dto.MarketplaceCaseResponse{
-			ID:          caseRecord.ID,
-			Title:       caseRecord.Title,
-			Category:    caseRecord.Category,
-			Description: description,
-			CreatedAt:   utils.PgtypeTimeToTime(caseRecord.CreatedAt),
-		})
+		quotesCount := int(caseRecord.QuoteCount)
+		caseResp := dto.MarketplaceCaseResponse{
+			ID:           caseRecord.ID,
+			Title:        caseRecord.Title,
+			Category:     caseRecord.Category,
+			Description:  description,
+			Jurisdiction: utils.GetNullableString(caseRecord.Jurisdiction),
+			Deadline:     nullableTime(caseRecord.Deadline),
+			QuotesCount:  &quotesCount,
+			CreatedAt:    utils.PgtypeTimeToTime(caseRecord.CreatedAt),
+		}
+		if query != "" {
+			snippet := highlightSnippet(caseRecord.Snippet)
+			caseResp.Snippet = &snippet
+			caseResp.Relevance = caseRecord.Rank
+		}
+		result = append(result, caseResp)
 	}
 
-	return result, int64(total), nil
+	return result, total, nextCursor, nil
 }
 
 func (s *MarketplaceService) GetCaseForMarketplace(ctx context.Context, caseID uuid.UUID, lawyerID *uuid.UUID) (*dto.MarketplaceCaseResponse, error) {
-	caseRecord, err := s.repo.GetCaseByID(ctx, caseID)
+	caseRecord, err := s.repo.GetCaseWithClient(ctx, caseID)
 	if err != nil {
 		return nil, fmt.Errorf("case not found: %w", err)
 	}
 
-	description := anonymizeDescription(caseRecord.Description)
+	subject := redactionSubject(caseRecord.Jurisdiction, caseRecord.ClientName, caseRecord.ClientEmail)
+	description, err := marketplaceDescription(ctx, s.repo, s.redactor, caseID, caseRecord.Description, caseRecord.RedactedDescription,
+		caseRecord.RedactedAt, caseRecord.RedactionVersion, subject)
+	if err != nil {
+		return nil, err
+	}
 
 	response := &dto.MarketplaceCaseResponse{
 		ID:           caseRecord.ID,
 		Title:        caseRecord.Title,
 		Category:     caseRecord.Category,
 		Description:  description,
+		Jurisdiction: utils.GetNullableString(caseRecord.Jurisdiction),
+		Deadline:     nullableTime(caseRecord.Deadline),
 		CreatedAt:    utils.PgtypeTimeToTime(caseRecord.CreatedAt),
 		Status:       caseRecord.Status,
 		HasSubmitted: false,
@@ -104,12 +234,35 @@ func (s *MarketplaceService) GetCaseForMarketplace(ctx context.Context, caseID u
 	})
 	if err == nil && quote != nil {
 		response.HasSubmitted = true
+
+		revisions, err := s.repo.GetCaseRevisions(ctx, caseID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get case revisions: %w", err)
+		}
+		response.Revisions = caseRevisionsToResponse(revisions)
+		for i, revision := range revisions {
+			if revision.RedactedDescription.Valid {
+				response.Revisions[i].Description = revision.RedactedDescription.String
+				continue
+			}
+			// Revisions saved before their redaction was stored.
+			if subject.Phrases == nil {
+				subject.Phrases, err = manualRedactionPhrases(ctx, s.repo, caseID)
+				if err != nil {
+					return nil, err
+				}
+			}
+			response.Revisions[i].Description = s.redactor.Redact(revision.Description, subject).Text
+		}
+		if len(revisions) > 0 {
+			response.ChangedSinceMyQuote = utils.PgtypeTimeToTime(revisions[0].CreatedAt).After(utils.PgtypeTimeToTime(quote.UpdatedAt))
+		}
 	}
 
 	acceptedQuote, err := s.repo.GetAcceptedQuoteByCaseID(ctx, caseID)
 	if err == nil && acceptedQuote != nil && acceptedQuote.LawyerID == *lawyerID {
 
-		if caseRecord.Status == "engaged" {
+		if lifecycle.SharesDetailsWithLawyer(caseRecord.Status) {
 
 			response.Description = caseRecord.Description
 
@@ -133,15 +286,16 @@ func (s *MarketplaceService) GetCaseForMarketplace(ctx context.Context, caseID u
 	return response, nil
 }
 
-func anonymizeDescription(description string) string {
-
-	emailRegex := regexp.MustCompile(`[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}`)
-	description = emailRegex.ReplaceAllString(description, "[email redacted]")
-
-	phoneRegex := regexp.MustCompile(`(?:\+?1[-.\s]?)?\(?[0-9]{3}\)?[-.\s]?[0-9]{3}[-.\s]?[0-9]{4}`)
-	description = phoneRegex.ReplaceAllString(description, "[phone redacted]")
-
-	description = strings.ReplaceAll(description, "@", "[at]")
+// sameJurisdiction compares jurisdictions the way users type them, ignoring
+// case and surrounding spaces.
+func sameJurisdiction(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
 
-	return description
+// highlightSnippet turns a ts_headline fragment, whose matches are wrapped in
+// « and », into HTML-safe text with <mark> tags around the matches.
+func highlightSnippet(snippet string) string {
+	escaped := html.EscapeString(snippet)
+	escaped = strings.ReplaceAll(escaped, "«", "<mark>")
+	return strings.ReplaceAll(escaped, "»", "</mark>")
 }
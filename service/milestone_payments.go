@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/lifecycle"
+	"github.com/gadhittana01/cases-modules/utils"
+	dbUtils "github.com/gadhittana01/cases-modules/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ApproveMilestone lets the client sign off a delivered milestone. When another
+// milestone follows, its payment link is created right away. A link that
+// cannot be created does not undo the approval; the response says why, and
+// the client can pay through PayMilestone instead.
+func (s *PaymentService) ApproveMilestone(ctx context.Context, quoteID, milestoneID, clientID uuid.UUID) (*dto.MilestoneApprovalResponse, error) {
+	quote, caseRecord, err := s.getEngagedQuote(ctx, quoteID, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := getQuoteMilestone(ctx, s.repo, quoteID, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Status != "delivered" {
+		return nil, fmt.Errorf("only delivered milestones can be approved, this milestone is %s", existing.Status)
+	}
+
+	var milestone *repository.QuoteMilestone
+	var nextPayment *dto.PaymentIntentResponse
+	var linkErr error
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		// Every payment for the quote is requested under this lock, so the
+		// pending check below holds until the next link is recorded.
+		if _, err := txRepo.GetQuoteByIDForUpdate(ctx, quoteID); err != nil {
+			return fmt.Errorf("failed to get quote: %w", err)
+		}
+
+		var err error
+		milestone, err = txRepo.ApproveQuoteMilestone(ctx, milestoneID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("milestone was already approved")
+			}
+			return fmt.Errorf("failed to approve milestone: %w", err)
+		}
+
+		milestones, err := txRepo.GetQuoteMilestones(ctx, quoteID)
+		if err != nil {
+			return fmt.Errorf("failed to get quote milestones: %w", err)
+		}
+		next := payableMilestone(milestones)
+		if next == nil {
+			return nil
+		}
+
+		pendingPayments, err := txRepo.CountPendingPaymentsByQuoteID(ctx, quoteID)
+		if err != nil {
+			return fmt.Errorf("failed to check pending payments: %w", err)
+		}
+		if pendingPayments > 0 {
+			linkErr = fmt.Errorf("a payment is already pending for this quote")
+			return nil
+		}
+
+		// The link is recorded in a savepoint, so a failure rolls back only
+		// the payment record and the approval still commits.
+		savepoint, err := tx.Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to start savepoint: %w", err)
+		}
+		nextPayment, linkErr = s.createPaymentLink(ctx, s.repo.WithTx(savepoint), quote, caseRecord.Title,
+			getDecimalOrZero(utils.PgtypeNumericToDecimal(next.Amount)), "milestone", next)
+		if linkErr != nil {
+			return savepoint.Rollback(ctx)
+		}
+		return savepoint.Commit(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifyUser(s.pusherClient, quote.LawyerID, "milestone-approved", map[string]interface{}{
+		"case_id":      caseRecord.ID.String(),
+		"case_title":   caseRecord.Title,
+		"quote_id":     quoteID.String(),
+		"milestone_id": milestone.ID.String(),
+		"title":        milestone.Title,
+	})
+
+	response := &dto.MilestoneApprovalResponse{
+		Milestone:   quoteMilestoneToResponse(milestone),
+		NextPayment: nextPayment,
+	}
+	if linkErr != nil {
+		log.Printf("Milestone %s approved without a payment link for the next one: %v", milestoneID, linkErr)
+		message := fmt.Sprintf("the next milestone's payment link could not be created: %v", linkErr)
+		response.NextPaymentError = &message
+	}
+	return response, nil
+}
+
+// PayMilestone creates the payment link for the next milestone of an engaged
+// quote, e.g. when the link created on approval was not paid.
+func (s *PaymentService) PayMilestone(ctx context.Context, quoteID, milestoneID, clientID uuid.UUID) (*dto.PaymentIntentResponse, error) {
+	quote, caseRecord, err := s.getEngagedQuote(ctx, quoteID, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	var payment *dto.PaymentIntentResponse
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		if _, err := txRepo.GetQuoteByIDForUpdate(ctx, quoteID); err != nil {
+			return fmt.Errorf("failed to get quote: %w", err)
+		}
+
+		milestones, err := txRepo.GetQuoteMilestones(ctx, quoteID)
+		if err != nil {
+			return fmt.Errorf("failed to get quote milestones: %w", err)
+		}
+		next := payableMilestone(milestones)
+		if next == nil || next.ID != milestoneID {
+			return fmt.Errorf("milestone is not due for payment")
+		}
+
+		pendingPayments, err := txRepo.CountPendingPaymentsByQuoteID(ctx, quoteID)
+		if err != nil {
+			return fmt.Errorf("failed to check pending payments: %w", err)
+		}
+		if pendingPayments > 0 {
+			return fmt.Errorf("a payment is already pending for this quote")
+		}
+
+		payment, err = s.createPaymentLink(ctx, txRepo, quote, caseRecord.Title, getDecimalOrZero(utils.PgtypeNumericToDecimal(next.Amount)), "milestone", next)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return payment, nil
+}
+
+// getEngagedQuote loads the accepted quote of a client's engaged case.
+func (s *PaymentService) getEngagedQuote(ctx context.Context, quoteID, clientID uuid.UUID) (*repository.Quote, *repository.Case, error) {
+	quote, err := s.repo.GetQuoteByID(ctx, quoteID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("quote not found: %w", err)
+	}
+
+	caseRecord, err := s.repo.GetCaseByID(ctx, quote.CaseID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("case not found: %w", err)
+	}
+	if caseRecord.ClientID != clientID {
+		return nil, nil, fmt.Errorf("unauthorized: you can only manage milestones on your own cases")
+	}
+	if quote.Status != "accepted" || caseRecord.Status != lifecycle.CaseEngaged {
+		return nil, nil, fmt.Errorf("milestones can only be managed while the lawyer is engaged")
+	}
+
+	return quote, caseRecord, nil
+}
+
+// payableMilestone is the next milestone to pay for: the first unpaid one,
+// once every milestone before it is approved.
+func payableMilestone(milestones []*repository.QuoteMilestone) *repository.QuoteMilestone {
+	for _, milestone := range milestones {
+		switch milestone.Status {
+		case "approved":
+			continue
+		case "pending":
+			return milestone
+		default:
+			return nil
+		}
+	}
+	return nil
+}
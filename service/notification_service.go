@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-modules/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	pusher "github.com/pusher/pusher-http-go/v5"
+)
+
+const (
+	NotificationSavedSearchMatch = "saved-search-match"
+)
+
+type NotificationService struct {
+	repo repository.Repository
+}
+
+func NewNotificationService(repo repository.Repository) *NotificationService {
+	return &NotificationService{
+		repo: repo,
+	}
+}
+
+func (s *NotificationService) ListNotifications(ctx context.Context, userID uuid.UUID, unreadOnly bool, page, pageSize int) ([]dto.NotificationResponse, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = 10
+	}
+	offset := (page - 1) * pageSize
+
+	notifications, err := s.repo.ListNotificationsByUserID(ctx, &repository.ListNotificationsByUserIDParams{
+		UserID:  userID,
+		Column2: unreadOnly,
+		Limit:   int32(pageSize),
+		Offset:  int32(offset),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list notifications: %w", err)
+	}
+
+	total, err := s.repo.CountNotificationsByUserID(ctx, &repository.CountNotificationsByUserIDParams{
+		UserID:  userID,
+		Column2: unreadOnly,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count notifications: %w", err)
+	}
+
+	result := make([]dto.NotificationResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		result = append(result, notificationToResponse(notification))
+	}
+
+	return result, total, nil
+}
+
+func (s *NotificationService) MarkRead(ctx context.Context, userID, notificationID uuid.UUID) (*dto.NotificationResponse, error) {
+	notification, err := s.repo.MarkNotificationRead(ctx, &repository.MarkNotificationReadParams{
+		ID:     notificationID,
+		UserID: userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("notification not found")
+		}
+		return nil, fmt.Errorf("failed to mark notification read: %w", err)
+	}
+
+	response := notificationToResponse(notification)
+	return &response, nil
+}
+
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID uuid.UUID) error {
+	if err := s.repo.MarkAllNotificationsRead(ctx, userID); err != nil {
+		return fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	return nil
+}
+
+// createNotification stores a notification in the user's inbox and pushes it
+// on their Pusher channel under the notification type as event name.
+func createNotification(ctx context.Context, repo repository.Querier, pusherClient *pusher.Client, userID uuid.UUID, notificationType string, data map[string]interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	notification, err := repo.CreateNotification(ctx, &repository.CreateNotificationParams{
+		UserID: userID,
+		Type:   notificationType,
+		Data:   payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	event := make(map[string]interface{}, len(data)+1)
+	for key, value := range data {
+		event[key] = value
+	}
+	event["notification_id"] = notification.ID
+	notifyUser(pusherClient, userID, notificationType, event)
+
+	return nil
+}
+
+func notificationToResponse(notification *repository.Notification) dto.NotificationResponse {
+	response := dto.NotificationResponse{
+		ID:        notification.ID,
+		Type:      notification.Type,
+		Data:      json.RawMessage(notification.Data),
+		CreatedAt: utils.PgtypeTimeToTime(notification.CreatedAt),
+	}
+	if notification.ReadAt.Valid {
+		response.ReadAt = &notification.ReadAt.Time
+	}
+	return response
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	pusher "github.com/pusher/pusher-http-go/v5"
+)
+
+// userChannel is the private Pusher channel a user's client subscribes to for
+// notifications about their cases and quotes.
+func userChannel(userID uuid.UUID) string {
+	return fmt.Sprintf("user-%s", userID.String())
+}
+
+// notifyUser emits an event on the user's channel. Pusher is optional, so a
+// missing client or a failed delivery is only logged.
+func notifyUser(pusherClient *pusher.Client, userID uuid.UUID, event string, data map[string]interface{}) {
+	if pusherClient == nil {
+		return
+	}
+	if err := pusherClient.Trigger(userChannel(userID), event, data); err != nil {
+		log.Printf("Failed to emit Pusher event %s to user %s: %v", event, userID, err)
+	}
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// pageCursor identifies the last row of a page for keyset pagination on
+// (created_at, id). Sort and SortKey are only set by the marketplace, whose
+// listing can be ordered by other keys first.
+type pageCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"id"`
+	Sort      string    `json:"s,omitempty"`
+	SortKey   float64   `json:"k,omitempty"`
+}
+
+// encodeCursor returns the cursor as an opaque, URL-safe token.
+func encodeCursor(cursor pageCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor parses a token from encodeCursor. An empty token means the
+// request uses page/page_size instead and returns nil.
+func decodeCursor(token string) (*pageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var cursor pageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == uuid.Nil {
+		return nil, ErrInvalidCursor
+	}
+	return &cursor, nil
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor pageCursor
+	}{
+		{"created_at and id", pageCursor{
+			CreatedAt: time.Date(2025, 3, 14, 9, 26, 53, 589793000, time.UTC),
+			ID:        uuid.MustParse("6f1c2d9e-8a4b-4f3e-9c1d-2b7a5e8f0c13"),
+		}},
+		{"non-UTC created_at", pageCursor{
+			CreatedAt: time.Date(2025, 12, 31, 23, 59, 59, 0, time.FixedZone("SGT", 8*60*60)),
+			ID:        uuid.MustParse("0b5e7a3c-1d2f-4e6a-8b9c-3f4d5e6a7b8c"),
+		}},
+		{"marketplace deadline sort key", pageCursor{
+			CreatedAt: time.Date(2025, 3, 14, 9, 26, 53, 0, time.UTC),
+			ID:        uuid.MustParse("6f1c2d9e-8a4b-4f3e-9c1d-2b7a5e8f0c13"),
+			Sort:      MarketplaceSortDeadline,
+			SortKey:   1767225599,
+		}},
+		{"marketplace zero sort key", pageCursor{
+			CreatedAt: time.Date(2025, 3, 14, 9, 26, 53, 0, time.UTC),
+			ID:        uuid.MustParse("6f1c2d9e-8a4b-4f3e-9c1d-2b7a5e8f0c13"),
+			Sort:      MarketplaceSortFewestQuotes,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := encodeCursor(tt.cursor)
+			decoded, err := decodeCursor(token)
+			if err != nil {
+				t.Fatalf("decodeCursor(%q) returned error: %v", token, err)
+			}
+			if !decoded.CreatedAt.Equal(tt.cursor.CreatedAt) || decoded.ID != tt.cursor.ID ||
+				decoded.Sort != tt.cursor.Sort || decoded.SortKey != tt.cursor.SortKey {
+				t.Fatalf("decodeCursor(encodeCursor(%+v)) = %+v", tt.cursor, *decoded)
+			}
+		})
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantNil bool
+		wantErr error
+	}{
+		{"empty token uses page/page_size", "", true, nil},
+		{"not base64", "not a cursor!", true, ErrInvalidCursor},
+		{"not json", encode("created_at=yesterday"), true, ErrInvalidCursor},
+		{"missing id", encode(`{"t":"2025-03-14T09:26:53Z"}`), true, ErrInvalidCursor},
+		{"nil id", encode(`{"t":"2025-03-14T09:26:53Z","id":"00000000-0000-0000-0000-000000000000"}`), true, ErrInvalidCursor},
+		{"malformed id", encode(`{"t":"2025-03-14T09:26:53Z","id":"42"}`), true, ErrInvalidCursor},
+		{"valid", encode(`{"t":"2025-03-14T09:26:53Z","id":"6f1c2d9e-8a4b-4f3e-9c1d-2b7a5e8f0c13"}`), false, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := decodeCursor(tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("decodeCursor(%q) error = %v, want %v", tt.token, err, tt.wantErr)
+			}
+			if (cursor == nil) != tt.wantNil {
+				t.Fatalf("decodeCursor(%q) = %+v, want nil: %v", tt.token, cursor, tt.wantNil)
+			}
+		})
+	}
+}
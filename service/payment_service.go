@@ -7,10 +7,12 @@ import (
 
 	"github.com/gadhittana01/cases-app-server/db/repository"
 	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/lifecycle"
 	"github.com/gadhittana01/cases-modules/utils"
 	dbUtils "github.com/gadhittana01/cases-modules/utils"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	pusher "github.com/pusher/pusher-http-go/v5"
 	"github.com/shopspring/decimal"
 	stripe "github.com/stripe/stripe-go/v76"
@@ -25,15 +27,17 @@ type PaymentService struct {
 	repo         repository.Repository
 	config       *utils.Config
 	pusherClient *pusher.Client
+	expiry       QuoteExpiryConfig
 }
 
-func NewPaymentService(repo repository.Repository, config *utils.Config, pusherClient *pusher.Client) *PaymentService {
+func NewPaymentService(repo repository.Repository, config *utils.Config, pusherClient *pusher.Client, expiry QuoteExpiryConfig) *PaymentService {
 	stripe.Key = config.StripeSecret
 
 	return &PaymentService{
 		repo:         repo,
 		config:       config,
 		pusherClient: pusherClient,
+		expiry:       expiry,
 	}
 }
 
@@ -56,124 +60,171 @@ func (s *PaymentService) AcceptQuote(ctx context.Context, quoteID, clientID uuid
 		return nil, fmt.Errorf("unauthorized: you can only accept quotes for your own cases")
 	}
 
-	if caseRecord.Status != "open" {
+	if !lifecycle.AcceptsQuotes(caseRecord.Status) {
 		return nil, fmt.Errorf("case is not open for acceptance")
 	}
 
+	if s.expiry.isExpired(quote) {
+		return nil, fmt.Errorf("quote has expired")
+	}
 	if quote.Status != "proposed" {
 		return nil, fmt.Errorf("quote is not available for acceptance")
 	}
 
-	amountDecimal := utils.PgtypeNumericToDecimal(quote.Amount)
+	milestones, err := s.repo.GetQuoteMilestones(ctx, quoteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quote milestones: %w", err)
+	}
+
+	// Milestone quotes are paid one stage at a time, starting with the first,
+	// and hourly quotes start with the deposit.
+	var firstMilestone *repository.QuoteMilestone
+	kind := "full"
+	amountDecimal := quoteChargeAmount(quote)
+	if len(milestones) > 0 {
+		firstMilestone = milestones[0]
+		kind = "milestone"
+		amountDecimal = utils.PgtypeNumericToDecimal(firstMilestone.Amount)
+	} else if isHourlyPricing(quote.PricingModel) {
+		kind = "deposit"
+		amountDecimal = utils.PgtypeNumericToDecimal(quote.DepositAmount)
+	}
 	if amountDecimal == nil {
 		return nil, fmt.Errorf("invalid quote amount")
 	}
-	amountCents := amountDecimal.Mul(decimal.NewFromInt(100)).IntPart()
-
-	var paymentLinkURL string
-	var paymentLinkID string
 
+	var response *dto.PaymentIntentResponse
 	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
 		txRepo := s.repo.WithTx(tx)
 
-		quoteCheck, err := txRepo.GetQuoteByID(ctx, quoteID)
+		// Withdrawing, declining and expiring the quote lock the same row, so
+		// none of them can slip in before the pending payment is recorded.
+		quoteCheck, err := txRepo.GetQuoteByIDForUpdate(ctx, quoteID)
 		if err != nil {
 			return err
 		}
 		if quoteCheck.Status != "proposed" {
 			return fmt.Errorf("quote was already processed")
 		}
-
-		productParams := &stripe.ProductParams{
-			Name: stripe.String(fmt.Sprintf("Legal Services - Case: %s", caseRecord.Title)),
-			Metadata: map[string]string{
-				"quote_id": quoteID.String(),
-				"case_id":  quote.CaseID.String(),
-			},
+		if s.expiry.isExpired(quoteCheck) {
+			return fmt.Errorf("quote has expired")
 		}
-		prod, err := product.New(productParams)
-		if err != nil {
-			return fmt.Errorf("failed to create product: %w", err)
+		if !quoteCheck.UpdatedAt.Time.Equal(quote.UpdatedAt.Time) {
+			return fmt.Errorf("quote terms changed, please review them and try again")
 		}
 
-		priceParams := &stripe.PriceParams{
-			Currency:   stripe.String(string(stripe.CurrencySGD)),
-			Product:    stripe.String(prod.ID),
-			UnitAmount: stripe.Int64(amountCents),
-		}
-		priceObj, err := price.New(priceParams)
-		if err != nil {
-			return fmt.Errorf("failed to create price: %w", err)
-		}
+		response, err = s.createPaymentLink(ctx, txRepo, quote, caseRecord.Title, *amountDecimal, kind, firstMilestone)
+		return err
+	})
 
-		initialReturnURL := fmt.Sprintf("%s/client/cases/%s/payment/processing", s.getFrontendURL(), quote.CaseID.String())
-		params := &stripe.PaymentLinkParams{
-			LineItems: []*stripe.PaymentLinkLineItemParams{
-				{
-					Price:    stripe.String(priceObj.ID),
-					Quantity: stripe.Int64(1),
-				},
-			},
-			Metadata: map[string]string{
-				"quote_id":        quoteID.String(),
-				"case_id":         quote.CaseID.String(),
-				"payment_link_id": "",
-			},
-			AfterCompletion: &stripe.PaymentLinkAfterCompletionParams{
-				Type: stripe.String("redirect"),
-				Redirect: &stripe.PaymentLinkAfterCompletionRedirectParams{
-					URL: stripe.String(initialReturnURL),
-				},
-			},
-		}
+	if err != nil {
+		return nil, err
+	}
 
-		pl, err := paymentlink.New(params)
-		if err != nil {
-			return fmt.Errorf("failed to create payment link: %w", err)
+	return response, nil
+}
+
+// createPaymentLink creates a Stripe payment link for the amount and records
+// the pending payment of the given kind ("full", "milestone", "deposit" or
+// "top_up"), tied to the milestone it pays for if there is one.
+func (s *PaymentService) createPaymentLink(ctx context.Context, repo repository.Querier, quote *repository.Quote, caseTitle string, amount decimal.Decimal, kind string, milestone *repository.QuoteMilestone) (*dto.PaymentIntentResponse, error) {
+	amountCents := amount.Mul(decimal.NewFromInt(100)).IntPart()
+
+	productName := fmt.Sprintf("Legal Services - Case: %s", caseTitle)
+	productMetadata := map[string]string{
+		"quote_id": quote.ID.String(),
+		"case_id":  quote.CaseID.String(),
+	}
+	var milestoneID pgtype.UUID
+	switch {
+	case milestone != nil:
+		productName = fmt.Sprintf("%s - Milestone %d: %s", productName, milestone.Position, milestone.Title)
+		productMetadata["milestone_id"] = milestone.ID.String()
+		milestoneID = utils.UUIDToPgtypeUUID(&milestone.ID)
+	case kind == "deposit":
+		productName += " - Deposit"
+	case kind == "top_up":
+		productName += " - Top-up"
+	}
+	linkMetadata := func(paymentLinkID string) map[string]string {
+		metadata := map[string]string{"payment_link_id": paymentLinkID}
+		for key, value := range productMetadata {
+			metadata[key] = value
 		}
+		return metadata
+	}
 
-		paymentLinkID = pl.ID
-		paymentLinkURL = pl.URL
+	productParams := &stripe.ProductParams{
+		Name:     stripe.String(productName),
+		Metadata: productMetadata,
+	}
+	prod, err := product.New(productParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create product: %w", err)
+	}
 
-		returnURL := fmt.Sprintf("%s/client/cases/%s/payment/processing?payment_link_id=%s", s.getFrontendURL(), quote.CaseID.String(), paymentLinkID)
-		updateParams := &stripe.PaymentLinkParams{
-			Metadata: map[string]string{
-				"quote_id":        quoteID.String(),
-				"case_id":         quote.CaseID.String(),
-				"payment_link_id": paymentLinkID,
+	priceParams := &stripe.PriceParams{
+		Currency:   stripe.String(string(stripe.CurrencySGD)),
+		Product:    stripe.String(prod.ID),
+		UnitAmount: stripe.Int64(amountCents),
+	}
+	priceObj, err := price.New(priceParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create price: %w", err)
+	}
+
+	initialReturnURL := fmt.Sprintf("%s/client/cases/%s/payment/processing", s.getFrontendURL(), quote.CaseID.String())
+	params := &stripe.PaymentLinkParams{
+		LineItems: []*stripe.PaymentLinkLineItemParams{
+			{
+				Price:    stripe.String(priceObj.ID),
+				Quantity: stripe.Int64(1),
 			},
-			AfterCompletion: &stripe.PaymentLinkAfterCompletionParams{
-				Type: stripe.String("redirect"),
-				Redirect: &stripe.PaymentLinkAfterCompletionRedirectParams{
-					URL: stripe.String(returnURL),
-				},
+		},
+		Metadata: linkMetadata(""),
+		AfterCompletion: &stripe.PaymentLinkAfterCompletionParams{
+			Type: stripe.String("redirect"),
+			Redirect: &stripe.PaymentLinkAfterCompletionRedirectParams{
+				URL: stripe.String(initialReturnURL),
 			},
-		}
-		_, err = paymentlink.Update(paymentLinkID, updateParams)
-		if err != nil {
-			log.Printf("Failed to update payment link return URL: %v", err)
-		}
+		},
+	}
 
-		_, err = txRepo.CreatePayment(ctx, &repository.CreatePaymentParams{
-			QuoteID:               quoteID,
-			StripePaymentIntentID: paymentLinkID,
-			Amount:                utils.DecimalToPgtypeNumeric(*amountDecimal),
-			Status:                "pending",
-		})
-		if err != nil {
-			return fmt.Errorf("failed to create payment record: %w", err)
-		}
+	pl, err := paymentlink.New(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create payment link: %w", err)
+	}
 
-		return nil
-	})
+	returnURL := fmt.Sprintf("%s/client/cases/%s/payment/processing?payment_link_id=%s", s.getFrontendURL(), quote.CaseID.String(), pl.ID)
+	updateParams := &stripe.PaymentLinkParams{
+		Metadata: linkMetadata(pl.ID),
+		AfterCompletion: &stripe.PaymentLinkAfterCompletionParams{
+			Type: stripe.String("redirect"),
+			Redirect: &stripe.PaymentLinkAfterCompletionRedirectParams{
+				URL: stripe.String(returnURL),
+			},
+		},
+	}
+	_, err = paymentlink.Update(pl.ID, updateParams)
+	if err != nil {
+		log.Printf("Failed to update payment link return URL: %v", err)
+	}
 
+	_, err = repo.CreatePayment(ctx, &repository.CreatePaymentParams{
+		QuoteID:               quote.ID,
+		StripePaymentIntentID: pl.ID,
+		Amount:                utils.DecimalToPgtypeNumeric(amount),
+		Status:                "pending",
+		MilestoneID:           milestoneID,
+		Kind:                  kind,
+	})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to create payment record: %w", err)
 	}
 
 	return &dto.PaymentIntentResponse{
-		PaymentIntentID: paymentLinkID,
-		PaymentLinkURL:  paymentLinkURL,
+		PaymentIntentID: pl.ID,
+		PaymentLinkURL:  pl.URL,
 	}, nil
 }
 
@@ -250,10 +301,14 @@ func (s *PaymentService) HandlePaymentWebhook(ctx context.Context, checkoutSessi
 		return fmt.Errorf("case not found: %w", err)
 	}
 
+	if quote.Status == "accepted" && (payment.MilestoneID.Valid || payment.Kind == "top_up") {
+		return s.handleFollowUpPayment(ctx, paymentLinkID, payment, quote, caseRecord)
+	}
+
 	if quote.Status != "proposed" {
 		return fmt.Errorf("quote already processed, status: %s", quote.Status)
 	}
-	if caseRecord.Status != "open" {
+	if !lifecycle.AcceptsQuotes(caseRecord.Status) {
 		return fmt.Errorf("case already processed, status: %s", caseRecord.Status)
 	}
 
@@ -279,11 +334,8 @@ func (s *PaymentService) HandlePaymentWebhook(ctx context.Context, checkoutSessi
 			return fmt.Errorf("failed to reject other quotes: %w", err)
 		}
 
-		if _, err := txRepo.UpdateCaseStatus(ctx, &repository.UpdateCaseStatusParams{
-			ID:     quote.CaseID,
-			Status: "engaged",
-		}); err != nil {
-			return fmt.Errorf("failed to update case status: %w", err)
+		if _, err := transitionCase(ctx, txRepo, quote.CaseID, lifecycle.CaseEngaged, caseActor{Role: lifecycle.ActorSystem}, "quote payment succeeded"); err != nil {
+			return err
 		}
 
 		if _, err := txRepo.UpdatePaymentStatus(ctx, &repository.UpdatePaymentStatusParams{
@@ -293,6 +345,12 @@ func (s *PaymentService) HandlePaymentWebhook(ctx context.Context, checkoutSessi
 			return fmt.Errorf("failed to update payment status: %w", err)
 		}
 
+		if payment.MilestoneID.Valid {
+			if _, err := txRepo.MarkQuoteMilestonePaid(ctx, uuid.UUID(payment.MilestoneID.Bytes)); err != nil {
+				return fmt.Errorf("failed to mark milestone paid: %w", err)
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -306,7 +364,7 @@ func (s *PaymentService) HandlePaymentWebhook(ctx context.Context, checkoutSessi
 		"quote_id":       quote.ID.String(),
 		"quote_status":   "accepted",
 		"case_id":        quote.CaseID.String(),
-		"case_status":    "engaged",
+		"case_status":    lifecycle.CaseEngaged,
 		"is_completed":   true,
 	}
 	if err := s.pusherClient.Trigger(channel, "payment-completed", eventData); err != nil {
@@ -318,6 +376,67 @@ func (s *PaymentService) HandlePaymentWebhook(ctx context.Context, checkoutSessi
 	return nil
 }
 
+// handleFollowUpPayment settles a payment made after the quote was accepted:
+// a later milestone or a top-up of an hourly quote.
+func (s *PaymentService) handleFollowUpPayment(ctx context.Context, paymentLinkID string, payment *repository.Payment, quote *repository.Quote, caseRecord *repository.Case) error {
+	if payment.Status != "pending" {
+		return fmt.Errorf("payment already processed, status: %s", payment.Status)
+	}
+
+	err := dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		if payment.MilestoneID.Valid {
+			if _, err := txRepo.MarkQuoteMilestonePaid(ctx, uuid.UUID(payment.MilestoneID.Bytes)); err != nil {
+				return fmt.Errorf("failed to mark milestone paid: %w", err)
+			}
+		}
+
+		if _, err := txRepo.UpdatePaymentStatus(ctx, &repository.UpdatePaymentStatusParams{
+			ID:     payment.ID,
+			Status: "succeeded",
+		}); err != nil {
+			return fmt.Errorf("failed to update payment status: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	channel := fmt.Sprintf("payment-%s", paymentLinkID)
+	eventData := map[string]interface{}{
+		"payment_id":     payment.ID.String(),
+		"payment_status": "succeeded",
+		"payment_kind":   payment.Kind,
+		"quote_id":       quote.ID.String(),
+		"case_id":        quote.CaseID.String(),
+		"case_status":    caseRecord.Status,
+		"is_completed":   true,
+	}
+	lawyerEvent := "top-up-paid"
+	lawyerData := map[string]interface{}{
+		"case_id":    caseRecord.ID.String(),
+		"case_title": caseRecord.Title,
+		"quote_id":   quote.ID.String(),
+		"amount":     getDecimalOrZero(utils.PgtypeNumericToDecimal(payment.Amount)).String(),
+	}
+	if payment.MilestoneID.Valid {
+		milestoneID := uuid.UUID(payment.MilestoneID.Bytes).String()
+		eventData["milestone_id"] = milestoneID
+		lawyerEvent = "milestone-paid"
+		lawyerData["milestone_id"] = milestoneID
+	}
+
+	if err := s.pusherClient.Trigger(channel, "payment-completed", eventData); err != nil {
+		log.Printf("Failed to emit Pusher event: %v", err)
+	}
+	notifyUser(s.pusherClient, quote.LawyerID, lawyerEvent, lawyerData)
+
+	return nil
+}
+
 func (s *PaymentService) extractPaymentLinkID(ctx context.Context, checkoutSession *stripe.CheckoutSession) string {
 	if checkoutSession.PaymentLink != nil {
 		return checkoutSession.PaymentLink.ID
@@ -339,3 +458,16 @@ func (s *PaymentService) extractPaymentLinkID(ctx context.Context, checkoutSessi
 
 	return ""
 }
+
+// deactivatePaymentLinks disables the Stripe payment links behind cancelled
+// payments so they can no longer be paid.
+func deactivatePaymentLinks(payments []*repository.Payment) {
+	for _, payment := range payments {
+		_, err := paymentlink.Update(payment.StripePaymentIntentID, &stripe.PaymentLinkParams{
+			Active: stripe.Bool(false),
+		})
+		if err != nil {
+			log.Printf("Failed to deactivate payment link %s: %v", payment.StripePaymentIntentID, err)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-modules/utils"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// quoteCompareKeys are the comparison sort keys, each with its default order:
+// cheaper, faster and better reviewed quotes first.
+var quoteCompareKeys = map[string]struct {
+	less       func(a, b *dto.QuoteComparisonResponse) bool
+	descending bool
+}{
+	"amount":          {less: func(a, b *dto.QuoteComparisonResponse) bool { return a.Amount.LessThan(b.Amount) }},
+	"expected_days":   {less: func(a, b *dto.QuoteComparisonResponse) bool { return a.ExpectedDays < b.ExpectedDays }},
+	"price_per_day":   {less: func(a, b *dto.QuoteComparisonResponse) bool { return a.PricePerDay.LessThan(b.PricePerDay) }},
+	"rating":          {less: func(a, b *dto.QuoteComparisonResponse) bool { return ratingOrZero(a) < ratingOrZero(b) }, descending: true},
+	"completed_cases": {less: func(a, b *dto.QuoteComparisonResponse) bool { return a.CompletedCases < b.CompletedCases }, descending: true},
+	"revisions":       {less: func(a, b *dto.QuoteComparisonResponse) bool { return a.RevisionCount < b.RevisionCount }},
+	"created_at":      {less: func(a, b *dto.QuoteComparisonResponse) bool { return a.CreatedAt.Before(b.CreatedAt) }},
+}
+
+// CompareQuotes lists the quotes on a client's case with the metrics needed to
+// compare them side by side, filtered and sorted as requested.
+func (s *QuoteService) CompareQuotes(ctx context.Context, caseID, clientID uuid.UUID, filters dto.QuoteCompareFilters) ([]dto.QuoteComparisonResponse, error) {
+	sortKey := filters.Sort
+	if sortKey == "" {
+		sortKey = "amount"
+	}
+	key, ok := quoteCompareKeys[sortKey]
+	if !ok {
+		return nil, fmt.Errorf("invalid sort %q, use amount, expected_days, price_per_day, rating, completed_cases, revisions or created_at", filters.Sort)
+	}
+	descending := key.descending
+	if filters.Order != "" {
+		descending = filters.Order == "desc"
+	}
+
+	var maxAmount *decimal.Decimal
+	if filters.MaxAmount != "" {
+		amount, err := decimal.NewFromString(filters.MaxAmount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max_amount: %w", err)
+		}
+		maxAmount = &amount
+	}
+
+	status := strings.TrimSpace(filters.Status)
+	if status == "" {
+		status = "proposed"
+	}
+
+	caseRecord, err := s.repo.GetCaseByID(ctx, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("case not found: %w", err)
+	}
+	if caseRecord.ClientID != clientID {
+		return nil, fmt.Errorf("unauthorized: you can only compare quotes on your own cases")
+	}
+
+	quotes, err := s.repo.CompareQuotesByCaseID(ctx, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quotes: %w", err)
+	}
+
+	result := make([]dto.QuoteComparisonResponse, 0, len(quotes))
+	for _, quote := range quotes {
+		if status != "all" && quote.Status != status {
+			continue
+		}
+
+		comparison := quoteToComparison(quote)
+		if maxAmount != nil && comparison.Amount.GreaterThan(*maxAmount) {
+			continue
+		}
+		if filters.MaxDays > 0 && comparison.ExpectedDays > filters.MaxDays {
+			continue
+		}
+		if filters.MinRating > 0 && ratingOrZero(&comparison) < filters.MinRating {
+			continue
+		}
+		result = append(result, comparison)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if descending {
+			return key.less(&result[j], &result[i])
+		}
+		return key.less(&result[i], &result[j])
+	})
+
+	return result, nil
+}
+
+// quoteToComparison compares a quote on the terms the client would be charged
+// for: an accepted counter-offer replaces the quoted amount and days.
+func quoteToComparison(quote *repository.CompareQuotesByCaseIDRow) dto.QuoteComparisonResponse {
+	amount := getDecimalOrZero(quoteChargeAmount(&repository.Quote{Amount: quote.Amount, AgreedAmount: quote.AgreedAmount}))
+	expectedDays := quote.ExpectedDays
+	if quote.AgreedDays.Valid {
+		expectedDays = quote.AgreedDays.Int32
+	}
+	pricePerDay := decimal.Zero
+	if expectedDays > 0 {
+		pricePerDay = amount.Div(decimal.NewFromInt32(expectedDays)).Round(2)
+	}
+
+	var averageRating *float64
+	if quote.ReviewCount > 0 {
+		rating := math.Round(quote.AverageRating*100) / 100
+		averageRating = &rating
+	}
+
+	pricing := quotePricingToResponse(quotePricingColumns{
+		PricingModel:      quote.PricingModel,
+		Amount:            quote.Amount,
+		AgreedAmount:      quote.AgreedAmount,
+		HourlyRate:        quote.HourlyRate,
+		EstimatedHoursMin: quote.EstimatedHoursMin,
+		EstimatedHoursMax: quote.EstimatedHoursMax,
+		CapAmount:         quote.CapAmount,
+		DepositAmount:     quote.DepositAmount,
+	})
+
+	return dto.QuoteComparisonResponse{
+		QuoteID:        quote.ID,
+		LawyerID:       quote.LawyerID,
+		LawyerName:     utils.GetNullableString(quote.LawyerName),
+		Status:         quote.Status,
+		Amount:         amount,
+		ExpectedDays:   int(expectedDays),
+		PricePerDay:    pricePerDay,
+		Pricing:        pricing,
+		Note:           utils.GetStringOrEmpty(utils.GetNullableString(quote.Note)),
+		ValidUntil:     nullableTime(quote.ValidUntil),
+		CompletedCases: int(quote.CompletedCases),
+		ReviewCount:    int(quote.ReviewCount),
+		AverageRating:  averageRating,
+		RevisionCount:  int(quote.RevisionCount),
+		CreatedAt:      utils.PgtypeTimeToTime(quote.CreatedAt),
+		UpdatedAt:      utils.PgtypeTimeToTime(quote.UpdatedAt),
+	}
+}
+
+func ratingOrZero(quote *dto.QuoteComparisonResponse) float64 {
+	if quote.AverageRating == nil {
+		return 0
+	}
+	return *quote.AverageRating
+}
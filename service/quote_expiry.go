@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-modules/utils"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// QuoteExpiryConfig controls how long quotes stay open and how often stale
+// ones are expired.
+type QuoteExpiryConfig struct {
+	// ValidityDays applies when a lawyer does not set valid_until.
+	ValidityDays  int
+	SweepInterval time.Duration
+}
+
+// quoteValidUntil returns the requested expiry, or the default validity
+// window from now.
+func (s *QuoteService) quoteValidUntil(requested *time.Time) (pgtype.Timestamptz, error) {
+	if requested != nil {
+		if !requested.After(time.Now()) {
+			return pgtype.Timestamptz{}, fmt.Errorf("valid_until must be in the future")
+		}
+		return utils.ToPgtypeTimestamptz(requested), nil
+	}
+
+	validUntil := time.Now().AddDate(0, 0, s.expiry.ValidityDays)
+	return utils.ToPgtypeTimestamptz(&validUntil), nil
+}
+
+// isExpired reports whether a quote has lapsed, including proposed quotes the
+// sweeper has not reached yet. Like the sweeper, it expires quotes without a
+// valid_until ValidityDays after they were created.
+func (c QuoteExpiryConfig) isExpired(quote *repository.Quote) bool {
+	if quote.Status == "expired" {
+		return true
+	}
+	if quote.Status != "proposed" {
+		return false
+	}
+
+	validUntil := quote.ValidUntil.Time
+	if !quote.ValidUntil.Valid {
+		validUntil = quote.CreatedAt.Time.AddDate(0, 0, c.ValidityDays)
+	}
+	return !validUntil.After(time.Now())
+}
+
+// ExpireStaleQuotes marks proposed quotes past their validity as expired and
+// tells the lawyer and the client. Quotes the client is paying for are left
+// alone, as are quotes a client is accepting right now; the next sweep
+// reaches them if the acceptance fails.
+func (s *QuoteService) ExpireStaleQuotes(ctx context.Context) (int, error) {
+	quotes, err := s.repo.ExpireStaleQuotes(ctx, int32(s.expiry.ValidityDays))
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire quotes: %w", err)
+	}
+
+	for _, quote := range quotes {
+		eventData := map[string]interface{}{
+			"case_id":      quote.CaseID.String(),
+			"quote_id":     quote.ID.String(),
+			"quote_status": quote.Status,
+		}
+		caseRecord, err := s.repo.GetCaseByID(ctx, quote.CaseID)
+		if err != nil {
+			log.Printf("Failed to load case %s for quote expiry notification: %v", quote.CaseID, err)
+			notifyUser(s.pusherClient, quote.LawyerID, "quote-expired", eventData)
+			continue
+		}
+		eventData["case_title"] = caseRecord.Title
+
+		notifyUser(s.pusherClient, quote.LawyerID, "quote-expired", eventData)
+		notifyUser(s.pusherClient, caseRecord.ClientID, "quote-expired", eventData)
+	}
+
+	return len(quotes), nil
+}
+
+// RunExpirySweeper expires stale quotes every SweepInterval until ctx is
+// done. It is meant for the long-running server; serverless deployments do
+// not run it.
+func (s *QuoteService) RunExpirySweeper(ctx context.Context) {
+	ticker := time.NewTicker(s.expiry.SweepInterval)
+	defer ticker.Stop()
+
+	for {
+		expired, err := s.ExpireStaleQuotes(ctx)
+		if err != nil {
+			log.Printf("Quote expiry sweep failed: %v", err)
+		} else if expired > 0 {
+			log.Printf("Expired %d stale quotes", expired)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func TestQuoteExpiryConfigIsExpired(t *testing.T) {
+	now := time.Now()
+	at := func(t time.Time) pgtype.Timestamptz {
+		return pgtype.Timestamptz{Time: t, Valid: true}
+	}
+	config := QuoteExpiryConfig{ValidityDays: 14}
+
+	tests := []struct {
+		name  string
+		quote repository.Quote
+		want  bool
+	}{
+		{"valid_until ahead", repository.Quote{Status: "proposed", ValidUntil: at(now.Add(time.Hour)), CreatedAt: at(now.AddDate(0, 0, -30))}, false},
+		{"valid_until passed", repository.Quote{Status: "proposed", ValidUntil: at(now.Add(-time.Hour)), CreatedAt: at(now.AddDate(0, 0, -1))}, true},
+		{"legacy quote inside default window", repository.Quote{Status: "proposed", CreatedAt: at(now.AddDate(0, 0, -13))}, false},
+		{"legacy quote past default window", repository.Quote{Status: "proposed", CreatedAt: at(now.AddDate(0, 0, -15))}, true},
+		{"already expired", repository.Quote{Status: "expired", ValidUntil: at(now.Add(time.Hour))}, true},
+		{"accepted quote past valid_until", repository.Quote{Status: "accepted", ValidUntil: at(now.Add(-time.Hour))}, false},
+		{"declined legacy quote", repository.Quote{Status: "declined", CreatedAt: at(now.AddDate(0, 0, -30))}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := config.isExpired(&tt.quote); got != tt.want {
+				t.Errorf("isExpired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-modules/utils"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// milestoneTerms is a validated milestone from a quote submission.
+type milestoneTerms struct {
+	title   string
+	amount  decimal.Decimal
+	dueDate time.Time
+}
+
+// parseMilestones validates the milestones of a quote: positive amounts adding
+// up to the quote amount and future due dates in order.
+func parseMilestones(milestones []dto.QuoteMilestoneRequest, total decimal.Decimal) ([]milestoneTerms, error) {
+	if len(milestones) == 0 {
+		return nil, nil
+	}
+
+	terms := make([]milestoneTerms, 0, len(milestones))
+	sum := decimal.Zero
+	now := time.Now()
+	for i, milestone := range milestones {
+		amount, err := parsePositiveDecimal(fmt.Sprintf("milestone %d amount", i+1), milestone.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if !milestone.DueDate.After(now) {
+			return nil, fmt.Errorf("milestone %d due date must be in the future", i+1)
+		}
+		if i > 0 && milestone.DueDate.Before(terms[i-1].dueDate) {
+			return nil, fmt.Errorf("milestone %d is due before the milestone it follows", i+1)
+		}
+
+		sum = sum.Add(*amount)
+		terms = append(terms, milestoneTerms{title: milestone.Title, amount: *amount, dueDate: milestone.DueDate})
+	}
+
+	if !sum.Equal(total) {
+		return nil, fmt.Errorf("milestone amounts add up to %s, not the quote amount %s", sum.String(), total.String())
+	}
+	return terms, nil
+}
+
+// saveQuoteMilestones replaces the milestones of a quote.
+func saveQuoteMilestones(ctx context.Context, repo repository.Querier, quoteID uuid.UUID, terms []milestoneTerms) ([]*repository.QuoteMilestone, error) {
+	if err := repo.DeleteQuoteMilestones(ctx, quoteID); err != nil {
+		return nil, fmt.Errorf("failed to clear quote milestones: %w", err)
+	}
+
+	milestones := make([]*repository.QuoteMilestone, 0, len(terms))
+	for i, term := range terms {
+		dueDate := term.dueDate
+		milestone, err := repo.CreateQuoteMilestone(ctx, &repository.CreateQuoteMilestoneParams{
+			QuoteID:  quoteID,
+			Position: int32(i + 1),
+			Title:    term.title,
+			Amount:   utils.DecimalToPgtypeNumeric(term.amount),
+			DueDate:  utils.ToPgtypeTimestamptz(&dueDate),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to save quote milestone: %w", err)
+		}
+		milestones = append(milestones, milestone)
+	}
+	return milestones, nil
+}
+
+// sameMilestones reports whether a submission leaves the milestones as they are.
+func sameMilestones(existing []*repository.QuoteMilestone, terms []milestoneTerms) bool {
+	if len(existing) != len(terms) {
+		return false
+	}
+	for i, milestone := range existing {
+		if milestone.Title != terms[i].title ||
+			!getDecimalOrZero(utils.PgtypeNumericToDecimal(milestone.Amount)).Equal(terms[i].amount) ||
+			!milestone.DueDate.Time.Equal(terms[i].dueDate) {
+			return false
+		}
+	}
+	return true
+}
+
+// revisionMilestone is a milestone as a quote revision keeps it.
+type revisionMilestone struct {
+	Title   string          `json:"title"`
+	Amount  decimal.Decimal `json:"amount"`
+	DueDate time.Time       `json:"due_date"`
+}
+
+// marshalRevisionMilestones snapshots the milestones a quote edit replaces.
+func marshalRevisionMilestones(milestones []*repository.QuoteMilestone) ([]byte, error) {
+	snapshot := make([]revisionMilestone, 0, len(milestones))
+	for _, milestone := range milestones {
+		snapshot = append(snapshot, revisionMilestone{
+			Title:   milestone.Title,
+			Amount:  getDecimalOrZero(utils.PgtypeNumericToDecimal(milestone.Amount)),
+			DueDate: utils.PgtypeTimeToTime(milestone.DueDate),
+		})
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to snapshot quote milestones: %w", err)
+	}
+	return data, nil
+}
+
+func revisionMilestonesToResponse(data []byte) []dto.QuoteRevisionMilestoneResponse {
+	if len(data) == 0 {
+		return nil
+	}
+	var snapshot []revisionMilestone
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		log.Printf("Failed to read quote revision milestones: %v", err)
+		return nil
+	}
+	if len(snapshot) == 0 {
+		return nil
+	}
+	result := make([]dto.QuoteRevisionMilestoneResponse, 0, len(snapshot))
+	for i, milestone := range snapshot {
+		result = append(result, dto.QuoteRevisionMilestoneResponse{
+			Position: i + 1,
+			Title:    milestone.Title,
+			Amount:   milestone.Amount,
+			DueDate:  milestone.DueDate,
+		})
+	}
+	return result
+}
+
+// DeliverMilestone lets the lawyer of an accepted quote mark a paid milestone
+// as delivered, so the client can approve it and pay for the next one.
+func (s *QuoteService) DeliverMilestone(ctx context.Context, quoteID, milestoneID, lawyerID uuid.UUID) (*dto.QuoteMilestoneResponse, error) {
+	quote, err := s.repo.GetQuoteByID(ctx, quoteID)
+	if err != nil {
+		return nil, fmt.Errorf("quote not found: %w", err)
+	}
+	if quote.LawyerID != lawyerID {
+		return nil, fmt.Errorf("unauthorized: you can only deliver milestones of your own quotes")
+	}
+	if quote.Status != "accepted" {
+		return nil, fmt.Errorf("milestones can only be delivered on accepted quotes")
+	}
+
+	existing, err := getQuoteMilestone(ctx, s.repo, quoteID, milestoneID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Status != "paid" {
+		return nil, fmt.Errorf("only paid milestones can be delivered, this milestone is %s", existing.Status)
+	}
+
+	milestone, err := s.repo.MarkQuoteMilestoneDelivered(ctx, milestoneID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deliver milestone: %w", err)
+	}
+
+	caseRecord, err := s.repo.GetCaseByID(ctx, quote.CaseID)
+	if err != nil {
+		return nil, fmt.Errorf("case not found: %w", err)
+	}
+	notifyUser(s.pusherClient, caseRecord.ClientID, "milestone-delivered", map[string]interface{}{
+		"case_id":      caseRecord.ID.String(),
+		"case_title":   caseRecord.Title,
+		"quote_id":     quoteID.String(),
+		"milestone_id": milestone.ID.String(),
+		"title":        milestone.Title,
+	})
+
+	response := quoteMilestoneToResponse(milestone)
+	return &response, nil
+}
+
+// getQuoteMilestone loads a milestone, making sure it belongs to the quote.
+func getQuoteMilestone(ctx context.Context, repo repository.Querier, quoteID, milestoneID uuid.UUID) (*repository.QuoteMilestone, error) {
+	milestone, err := repo.GetQuoteMilestoneByID(ctx, milestoneID)
+	if err != nil || milestone.QuoteID != quoteID {
+		return nil, fmt.Errorf("milestone not found")
+	}
+	return milestone, nil
+}
+
+func quoteMilestoneToResponse(milestone *repository.QuoteMilestone) dto.QuoteMilestoneResponse {
+	return dto.QuoteMilestoneResponse{
+		ID:          milestone.ID,
+		Position:    int(milestone.Position),
+		Title:       milestone.Title,
+		Amount:      getDecimalOrZero(utils.PgtypeNumericToDecimal(milestone.Amount)),
+		DueDate:     utils.PgtypeTimeToTime(milestone.DueDate),
+		Status:      milestone.Status,
+		PaidAt:      nullableTime(milestone.PaidAt),
+		DeliveredAt: nullableTime(milestone.DeliveredAt),
+		ApprovedAt:  nullableTime(milestone.ApprovedAt),
+	}
+}
+
+func quoteMilestonesToResponse(milestones []*repository.QuoteMilestone) []dto.QuoteMilestoneResponse {
+	if len(milestones) == 0 {
+		return nil
+	}
+	result := make([]dto.QuoteMilestoneResponse, 0, len(milestones))
+	for _, milestone := range milestones {
+		result = append(result, quoteMilestoneToResponse(milestone))
+	}
+	return result
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-modules/utils"
+	"github.com/shopspring/decimal"
+)
+
+func TestParseMilestones(t *testing.T) {
+	due := time.Now().Add(24 * time.Hour)
+	later := due.Add(24 * time.Hour)
+	milestone := func(amount string, dueDate time.Time) dto.QuoteMilestoneRequest {
+		return dto.QuoteMilestoneRequest{Title: "Stage", Amount: amount, DueDate: dueDate}
+	}
+
+	tests := []struct {
+		name       string
+		milestones []dto.QuoteMilestoneRequest
+		total      string
+		wantErr    bool
+	}{
+		{"no milestones", nil, "1000", false},
+		{"amounts add up", []dto.QuoteMilestoneRequest{milestone("400.50", due), milestone("599.50", later)}, "1000", false},
+		{"three decimal places", []dto.QuoteMilestoneRequest{milestone("400.505", due), milestone("599.495", later)}, "1000", true},
+		{"zero amount", []dto.QuoteMilestoneRequest{milestone("0", due), milestone("1000", later)}, "1000", true},
+		{"not a number", []dto.QuoteMilestoneRequest{milestone("half", due)}, "1000", true},
+		{"amounts fall short", []dto.QuoteMilestoneRequest{milestone("400", due), milestone("500", later)}, "1000", true},
+		{"due dates out of order", []dto.QuoteMilestoneRequest{milestone("500", later), milestone("500", due)}, "1000", true},
+		{"due date in the past", []dto.QuoteMilestoneRequest{milestone("1000", time.Now().Add(-time.Hour))}, "1000", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			terms, err := parseMilestones(tt.milestones, decimal.RequireFromString(tt.total))
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseMilestones() = %+v, want an error", terms)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseMilestones() error = %v", err)
+			}
+			if len(terms) != len(tt.milestones) {
+				t.Errorf("parseMilestones() returned %d terms, want %d", len(terms), len(tt.milestones))
+			}
+		})
+	}
+}
+
+func TestRevisionMilestonesRoundTrip(t *testing.T) {
+	dueFirst := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	dueSecond := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	milestone := func(title, amount string, due time.Time) *repository.QuoteMilestone {
+		return &repository.QuoteMilestone{
+			Title:   title,
+			Amount:  utils.DecimalToPgtypeNumeric(decimal.RequireFromString(amount)),
+			DueDate: utils.ToPgtypeTimestamptz(&due),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		milestones []*repository.QuoteMilestone
+		want       []dto.QuoteRevisionMilestoneResponse
+	}{
+		{"no milestones", nil, nil},
+		{"milestones in order", []*repository.QuoteMilestone{
+			milestone("Draft", "400.50", dueFirst),
+			milestone("Hearing", "599.50", dueSecond),
+		}, []dto.QuoteRevisionMilestoneResponse{
+			{Position: 1, Title: "Draft", Amount: decimal.RequireFromString("400.5"), DueDate: dueFirst},
+			{Position: 2, Title: "Hearing", Amount: decimal.RequireFromString("599.5"), DueDate: dueSecond},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := marshalRevisionMilestones(tt.milestones)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := revisionMilestonesToResponse(data)
+			if len(got) != len(tt.want) {
+				t.Fatalf("revisionMilestonesToResponse() = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].Position != tt.want[i].Position || got[i].Title != tt.want[i].Title ||
+					!got[i].Amount.Equal(tt.want[i].Amount) || !got[i].DueDate.Equal(tt.want[i].DueDate) {
+					t.Errorf("milestone %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRevisionMilestonesToResponseWithoutSnapshot(t *testing.T) {
+	for _, data := range [][]byte{nil, []byte("not json")} {
+		if got := revisionMilestonesToResponse(data); got != nil {
+			t.Errorf("revisionMilestonesToResponse(%q) = %+v, want nil", data, got)
+		}
+	}
+}
@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/lifecycle"
+	"github.com/gadhittana01/cases-modules/utils"
+	dbUtils "github.com/gadhittana01/cases-modules/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+// negotiation is a quote seen by one side of its negotiation.
+type negotiation struct {
+	quote        *repository.Quote
+	caseRecord   *repository.Case
+	counterparty uuid.UUID
+}
+
+// getNegotiation loads a quote for the client who owns its case or the lawyer
+// who made it.
+func (s *QuoteService) getNegotiation(ctx context.Context, quoteID, userID uuid.UUID, role string) (*negotiation, error) {
+	quote, err := s.repo.GetQuoteByID(ctx, quoteID)
+	if err != nil {
+		return nil, fmt.Errorf("quote not found: %w", err)
+	}
+
+	caseRecord, err := s.repo.GetCaseByID(ctx, quote.CaseID)
+	if err != nil {
+		return nil, fmt.Errorf("case not found: %w", err)
+	}
+
+	switch {
+	case role == "client" && caseRecord.ClientID == userID:
+		return &negotiation{quote: quote, caseRecord: caseRecord, counterparty: quote.LawyerID}, nil
+	case role == "lawyer" && quote.LawyerID == userID:
+		return &negotiation{quote: quote, caseRecord: caseRecord, counterparty: caseRecord.ClientID}, nil
+	default:
+		return nil, fmt.Errorf("unauthorized: you can only negotiate your own quotes")
+	}
+}
+
+// lockNegotiable locks the quote of a negotiation and makes sure its terms can
+// still change: the case takes quotes, the quote is a proposed, unexpired
+// fixed fee without milestones, and no payment is under way for it. Accepting
+// the quote locks the same row before it records a pending payment, so the
+// checks hold until the transaction commits.
+func (s *QuoteService) lockNegotiable(ctx context.Context, repo repository.Querier, n *negotiation) error {
+	quote, err := repo.GetQuoteByIDForUpdate(ctx, n.quote.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get quote: %w", err)
+	}
+	n.quote = quote
+
+	if !lifecycle.AcceptsQuotes(n.caseRecord.Status) {
+		return fmt.Errorf("case is not open for quotes")
+	}
+	if n.quote.Status != "proposed" || s.expiry.isExpired(n.quote) {
+		return fmt.Errorf("only proposed quotes can be negotiated")
+	}
+	if isHourlyPricing(n.quote.PricingModel) {
+		return fmt.Errorf("only fixed-fee quotes can be negotiated")
+	}
+
+	pendingPayments, err := repo.CountPendingPaymentsByQuoteID(ctx, n.quote.ID)
+	if err != nil {
+		return fmt.Errorf("failed to check pending payments: %w", err)
+	}
+	if pendingPayments > 0 {
+		return fmt.Errorf("a payment is in progress for this quote")
+	}
+
+	// An agreed amount could not be split across the milestones, so those
+	// terms change through the lawyer updating the quote instead.
+	milestones, err := repo.GetQuoteMilestones(ctx, n.quote.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get quote milestones: %w", err)
+	}
+	if len(milestones) > 0 {
+		return fmt.Errorf("quotes with milestones cannot be negotiated, ask the lawyer to update the quote instead")
+	}
+	return nil
+}
+
+// GetQuoteOffers lists the negotiation on a quote, oldest offer first.
+func (s *QuoteService) GetQuoteOffers(ctx context.Context, quoteID, userID uuid.UUID, role string) ([]dto.QuoteOfferResponse, error) {
+	if _, err := s.getNegotiation(ctx, quoteID, userID, role); err != nil {
+		return nil, err
+	}
+
+	offers, err := s.repo.GetQuoteOffersByQuoteID(ctx, quoteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quote offers: %w", err)
+	}
+
+	result := make([]dto.QuoteOfferResponse, 0, len(offers))
+	for _, offer := range offers {
+		result = append(result, *quoteOfferToResponse(offer))
+	}
+	return result, nil
+}
+
+// ProposeOffer puts new terms on the table. An open offer from the other side
+// is marked countered, and one of the proposer's own is superseded.
+func (s *QuoteService) ProposeOffer(ctx context.Context, quoteID, userID uuid.UUID, role string, req dto.QuoteOfferRequest) (*dto.QuoteOfferResponse, error) {
+	n, err := s.getNegotiation(ctx, quoteID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := parseOfferAmount(req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	var offer *repository.QuoteOffer
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		if err := s.lockNegotiable(ctx, txRepo, n); err != nil {
+			return err
+		}
+
+		if err := closePendingOffer(ctx, txRepo, quoteID, role); err != nil {
+			return err
+		}
+
+		var err error
+		offer, err = txRepo.CreateQuoteOffer(ctx, &repository.CreateQuoteOfferParams{
+			QuoteID:      quoteID,
+			ProposedBy:   userID,
+			ProposerRole: role,
+			Amount:       utils.DecimalToPgtypeNumeric(amount),
+			ExpectedDays: int32(req.ExpectedDays),
+			Note:         utils.ToPgtypeText(optionalString(req.Note)),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create offer: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifyUser(s.pusherClient, n.counterparty, "quote-offer-received", map[string]interface{}{
+		"case_id":       n.caseRecord.ID.String(),
+		"case_title":    n.caseRecord.Title,
+		"quote_id":      quoteID.String(),
+		"offer_id":      offer.ID.String(),
+		"amount":        amount.String(),
+		"expected_days": req.ExpectedDays,
+	})
+
+	return quoteOfferToResponse(offer), nil
+}
+
+// parseOfferAmount parses the amount of an offer, which becomes the quote's
+// agreed amount and is stored with two decimal places like the quote amount.
+func parseOfferAmount(value string) (decimal.Decimal, error) {
+	amount, err := parsePositiveDecimal("amount", value)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return *amount, nil
+}
+
+// closePendingOffer closes the open offer on a quote, if there is one, before
+// the given side proposes new terms.
+func closePendingOffer(ctx context.Context, repo repository.Querier, quoteID uuid.UUID, role string) error {
+	pending, err := repo.GetPendingQuoteOffer(ctx, quoteID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to get pending offer: %w", err)
+	}
+
+	status := "countered"
+	if pending.ProposerRole == role {
+		status = "superseded"
+	}
+	if _, err := repo.CloseQuoteOffer(ctx, &repository.CloseQuoteOfferParams{
+		ID:     pending.ID,
+		Status: status,
+	}); err != nil {
+		return fmt.Errorf("failed to close pending offer: %w", err)
+	}
+	return nil
+}
+
+// RespondToOffer accepts or declines the open offer from the other side.
+// Accepting makes its terms the quote's agreed terms, which the client is
+// charged when accepting the quote.
+func (s *QuoteService) RespondToOffer(ctx context.Context, quoteID, offerID, userID uuid.UUID, role string, accept bool) (*dto.QuoteOfferResponse, error) {
+	n, err := s.getNegotiation(ctx, quoteID, userID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	existingOffer, err := s.repo.GetQuoteOfferByID(ctx, offerID)
+	if err != nil || existingOffer.QuoteID != quoteID {
+		return nil, fmt.Errorf("offer not found")
+	}
+	if existingOffer.Status != "pending" {
+		return nil, fmt.Errorf("offer is already %s", existingOffer.Status)
+	}
+	if existingOffer.ProposerRole == role {
+		return nil, fmt.Errorf("you cannot respond to your own offer")
+	}
+
+	status := "declined"
+	if accept {
+		status = "accepted"
+	}
+
+	var offer *repository.QuoteOffer
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		if err := s.lockNegotiable(ctx, txRepo, n); err != nil {
+			return err
+		}
+
+		var err error
+		offer, err = txRepo.CloseQuoteOffer(ctx, &repository.CloseQuoteOfferParams{
+			ID:     offerID,
+			Status: status,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("offer was already answered")
+			}
+			return fmt.Errorf("failed to respond to offer: %w", err)
+		}
+
+		if accept {
+			_, err = txRepo.SetQuoteAgreedTerms(ctx, &repository.SetQuoteAgreedTermsParams{
+				ID:           quoteID,
+				AgreedAmount: offer.Amount,
+				AgreedDays:   pgtype.Int4{Int32: offer.ExpectedDays, Valid: true},
+			})
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return fmt.Errorf("quote is no longer open for negotiation")
+				}
+				return fmt.Errorf("failed to save agreed terms: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifyUser(s.pusherClient, n.counterparty, "quote-offer-"+status, map[string]interface{}{
+		"case_id":    n.caseRecord.ID.String(),
+		"case_title": n.caseRecord.Title,
+		"quote_id":   quoteID.String(),
+		"offer_id":   offer.ID.String(),
+	})
+
+	return quoteOfferToResponse(offer), nil
+}
+
+// quoteChargeAmount is what accepting a quote costs: the agreed amount once a
+// counter-offer was accepted, otherwise the quoted amount.
+func quoteChargeAmount(quote *repository.Quote) *decimal.Decimal {
+	if agreed := utils.PgtypeNumericToDecimal(quote.AgreedAmount); agreed != nil {
+		return agreed
+	}
+	return utils.PgtypeNumericToDecimal(quote.Amount)
+}
+
+func quoteOfferToResponse(offer *repository.QuoteOffer) *dto.QuoteOfferResponse {
+	return &dto.QuoteOfferResponse{
+		ID:           offer.ID,
+		QuoteID:      offer.QuoteID,
+		ProposerRole: offer.ProposerRole,
+		Amount:       getDecimalOrZero(utils.PgtypeNumericToDecimal(offer.Amount)),
+		ExpectedDays: int(offer.ExpectedDays),
+		Note:         offer.Note.String,
+		Status:       offer.Status,
+		CreatedAt:    utils.PgtypeTimeToTime(offer.CreatedAt),
+		RespondedAt:  nullableTime(offer.RespondedAt),
+	}
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-modules/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+// offerQuerier fakes the quote offer queries closePendingOffer uses. Like
+// sqlc, GetPendingQuoteOffer returns a non-nil offer alongside its error.
+type offerQuerier struct {
+	repository.Querier
+	pending  *repository.QuoteOffer
+	getErr   error
+	closeErr error
+	closed   []repository.CloseQuoteOfferParams
+}
+
+func (q *offerQuerier) GetPendingQuoteOffer(ctx context.Context, quoteID uuid.UUID) (*repository.QuoteOffer, error) {
+	if q.pending == nil {
+		return &repository.QuoteOffer{}, q.getErr
+	}
+	return q.pending, q.getErr
+}
+
+func (q *offerQuerier) CloseQuoteOffer(ctx context.Context, arg *repository.CloseQuoteOfferParams) (*repository.QuoteOffer, error) {
+	q.closed = append(q.closed, *arg)
+	if q.closeErr != nil {
+		return &repository.QuoteOffer{}, q.closeErr
+	}
+	return &repository.QuoteOffer{ID: arg.ID, Status: arg.Status}, nil
+}
+
+func TestClosePendingOffer(t *testing.T) {
+	offerID := uuid.New()
+	dbErr := errors.New("connection reset")
+
+	tests := []struct {
+		name       string
+		querier    *offerQuerier
+		role       string
+		wantClosed []repository.CloseQuoteOfferParams
+		wantErr    error
+	}{
+		{
+			name:       "no pending offer",
+			querier:    &offerQuerier{getErr: pgx.ErrNoRows},
+			role:       "client",
+			wantClosed: nil,
+		},
+		{
+			name:       "other side's offer is countered",
+			querier:    &offerQuerier{pending: &repository.QuoteOffer{ID: offerID, ProposerRole: "lawyer", Status: "pending"}},
+			role:       "client",
+			wantClosed: []repository.CloseQuoteOfferParams{{ID: offerID, Status: "countered"}},
+		},
+		{
+			name:       "own offer is superseded",
+			querier:    &offerQuerier{pending: &repository.QuoteOffer{ID: offerID, ProposerRole: "lawyer", Status: "pending"}},
+			role:       "lawyer",
+			wantClosed: []repository.CloseQuoteOfferParams{{ID: offerID, Status: "superseded"}},
+		},
+		{
+			name:       "lookup fails",
+			querier:    &offerQuerier{getErr: dbErr},
+			role:       "client",
+			wantClosed: nil,
+			wantErr:    dbErr,
+		},
+		{
+			name:       "close fails",
+			querier:    &offerQuerier{pending: &repository.QuoteOffer{ID: offerID, ProposerRole: "client", Status: "pending"}, closeErr: dbErr},
+			role:       "lawyer",
+			wantClosed: []repository.CloseQuoteOfferParams{{ID: offerID, Status: "countered"}},
+			wantErr:    dbErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := closePendingOffer(context.Background(), tt.querier, uuid.New(), tt.role)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("closePendingOffer() returned error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("closePendingOffer() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(tt.querier.closed) != len(tt.wantClosed) {
+				t.Fatalf("closed offers = %+v, want %+v", tt.querier.closed, tt.wantClosed)
+			}
+			for i, closed := range tt.querier.closed {
+				if closed != tt.wantClosed[i] {
+					t.Errorf("closed offer %d = %+v, want %+v", i, closed, tt.wantClosed[i])
+				}
+			}
+		})
+	}
+}
+
+func TestQuoteToComparisonUsesAgreedTerms(t *testing.T) {
+	numeric := func(value string) pgtype.Numeric {
+		return utils.DecimalToPgtypeNumeric(decimal.RequireFromString(value))
+	}
+
+	tests := []struct {
+		name       string
+		quote      repository.CompareQuotesByCaseIDRow
+		wantAmount string
+		wantDays   int
+		wantPerDay string
+	}{
+		{
+			name:       "quoted terms",
+			quote:      repository.CompareQuotesByCaseIDRow{Amount: numeric("3000"), ExpectedDays: 30},
+			wantAmount: "3000", wantDays: 30, wantPerDay: "100",
+		},
+		{
+			name: "accepted counter-offer",
+			quote: repository.CompareQuotesByCaseIDRow{Amount: numeric("3000"), ExpectedDays: 30,
+				AgreedAmount: numeric("2400"), AgreedDays: pgtype.Int4{Int32: 20, Valid: true}},
+			wantAmount: "2400", wantDays: 20, wantPerDay: "120",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := quoteToComparison(&tt.quote)
+			if want := decimal.RequireFromString(tt.wantAmount); !got.Amount.Equal(want) {
+				t.Errorf("Amount = %s, want %s", got.Amount, want)
+			}
+			if got.ExpectedDays != tt.wantDays {
+				t.Errorf("ExpectedDays = %d, want %d", got.ExpectedDays, tt.wantDays)
+			}
+			if want := decimal.RequireFromString(tt.wantPerDay); !got.PricePerDay.Equal(want) {
+				t.Errorf("PricePerDay = %s, want %s", got.PricePerDay, want)
+			}
+		})
+	}
+}
+
+func TestParseOfferAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  string
+		want    string
+		wantErr bool
+	}{
+		{"whole amount", "1500", "1500", false},
+		{"two decimal places", "1499.99", "1499.99", false},
+		{"trailing zeros", "100.500", "100.5", false},
+		{"three decimal places", "100.005", "", true},
+		{"zero", "0", "", true},
+		{"negative", "-10", "", true},
+		{"not a number", "ten", "", true},
+		{"missing", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOfferAmount(tt.amount)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseOfferAmount(%q) = %s, want an error", tt.amount, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseOfferAmount(%q) error = %v", tt.amount, err)
+			}
+			if !got.Equal(decimal.RequireFromString(tt.want)) {
+				t.Errorf("parseOfferAmount(%q) = %s, want %s", tt.amount, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-modules/utils"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	pricingFixed  = "fixed"
+	pricingHourly = "hourly"
+	pricingCapped = "capped"
+)
+
+// quotePricing is the validated pricing of a quote submission. For hourly and
+// capped quotes amount is the highest estimated total.
+type quotePricing struct {
+	model             string
+	amount            decimal.Decimal
+	hourlyRate        *decimal.Decimal
+	estimatedHoursMin *decimal.Decimal
+	estimatedHoursMax *decimal.Decimal
+	capAmount         *decimal.Decimal
+	depositAmount     *decimal.Decimal
+}
+
+// isHourlyPricing reports whether a pricing model bills by the hour.
+func isHourlyPricing(model string) bool {
+	return model == pricingHourly || model == pricingCapped
+}
+
+// parseQuotePricing validates the pricing fields of a quote submission for its
+// pricing model.
+func parseQuotePricing(req dto.SubmitQuoteRequest) (*quotePricing, error) {
+	model := req.PricingModel
+	if model == "" {
+		model = pricingFixed
+	}
+
+	if !isHourlyPricing(model) {
+		if req.HourlyRate != "" || req.EstimatedHoursMin != "" || req.EstimatedHoursMax != "" || req.CapAmount != "" || req.DepositAmount != "" {
+			return nil, fmt.Errorf("hourly_rate, estimated hours, cap_amount and deposit_amount only apply to hourly and capped quotes")
+		}
+		amount, err := parsePositiveDecimal("amount", req.Amount)
+		if err != nil {
+			return nil, err
+		}
+		return &quotePricing{model: model, amount: *amount}, nil
+	}
+
+	if req.Amount != "" {
+		return nil, fmt.Errorf("the amount of %s quotes is calculated from the hourly rate", model)
+	}
+	if len(req.Milestones) > 0 {
+		return nil, fmt.Errorf("milestones only apply to fixed quotes")
+	}
+
+	pricing := &quotePricing{model: model}
+	var err error
+	if pricing.hourlyRate, err = parsePositiveDecimal("hourly_rate", req.HourlyRate); err != nil {
+		return nil, err
+	}
+	if pricing.estimatedHoursMin, err = parsePositiveDecimal("estimated_hours_min", req.EstimatedHoursMin); err != nil {
+		return nil, err
+	}
+	if pricing.estimatedHoursMax, err = parsePositiveDecimal("estimated_hours_max", req.EstimatedHoursMax); err != nil {
+		return nil, err
+	}
+	if pricing.estimatedHoursMax.LessThan(*pricing.estimatedHoursMin) {
+		return nil, fmt.Errorf("estimated_hours_max cannot be less than estimated_hours_min")
+	}
+	if pricing.depositAmount, err = parsePositiveDecimal("deposit_amount", req.DepositAmount); err != nil {
+		return nil, err
+	}
+
+	pricing.amount = pricing.hourlyRate.Mul(*pricing.estimatedHoursMax).Round(2)
+	if model == pricingCapped {
+		if pricing.capAmount, err = parsePositiveDecimal("cap_amount", req.CapAmount); err != nil {
+			return nil, err
+		}
+		if pricing.capAmount.LessThan(pricing.hourlyRate.Mul(*pricing.estimatedHoursMin).Round(2)) {
+			return nil, fmt.Errorf("cap_amount cannot be less than the estimated minimum total")
+		}
+		pricing.amount = decimal.Min(pricing.amount, *pricing.capAmount)
+	} else if req.CapAmount != "" {
+		return nil, fmt.Errorf("cap_amount only applies to capped quotes")
+	}
+
+	if pricing.depositAmount.GreaterThan(pricing.amount) {
+		return nil, fmt.Errorf("deposit_amount cannot exceed the estimated maximum total")
+	}
+	return pricing, nil
+}
+
+// parsePositiveDecimal parses an amount, rate or number of hours. All of them
+// are stored with two decimal places, so more precise values are refused
+// rather than rounded away from what totals were calculated with.
+func parsePositiveDecimal(field, value string) (*decimal.Decimal, error) {
+	if value == "" {
+		return nil, fmt.Errorf("%s is required", field)
+	}
+	parsed, err := decimal.NewFromString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s format: %w", field, err)
+	}
+	if !parsed.IsPositive() {
+		return nil, fmt.Errorf("%s must be greater than zero", field)
+	}
+	if !parsed.Equal(parsed.Round(2)) {
+		return nil, fmt.Errorf("%s can have at most 2 decimal places", field)
+	}
+	return &parsed, nil
+}
+
+// samePricing reports whether a submission leaves the pricing of a quote as it is.
+func samePricing(quote *repository.Quote, pricing *quotePricing) bool {
+	return quote.PricingModel == pricing.model &&
+		getDecimalOrZero(utils.PgtypeNumericToDecimal(quote.Amount)).Equal(pricing.amount) &&
+		sameDecimal(utils.PgtypeNumericToDecimal(quote.HourlyRate), pricing.hourlyRate) &&
+		sameDecimal(utils.PgtypeNumericToDecimal(quote.EstimatedHoursMin), pricing.estimatedHoursMin) &&
+		sameDecimal(utils.PgtypeNumericToDecimal(quote.EstimatedHoursMax), pricing.estimatedHoursMax) &&
+		sameDecimal(utils.PgtypeNumericToDecimal(quote.CapAmount), pricing.capAmount) &&
+		sameDecimal(utils.PgtypeNumericToDecimal(quote.DepositAmount), pricing.depositAmount)
+}
+
+func sameDecimal(a, b *decimal.Decimal) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func nullableNumeric(d *decimal.Decimal) pgtype.Numeric {
+	if d == nil {
+		return pgtype.Numeric{}
+	}
+	return utils.DecimalToPgtypeNumeric(*d)
+}
+
+// quotePricingColumns are the pricing columns of a quote, which the quote
+// query rows repeat.
+type quotePricingColumns struct {
+	PricingModel      string
+	Amount            pgtype.Numeric
+	AgreedAmount      pgtype.Numeric
+	HourlyRate        pgtype.Numeric
+	EstimatedHoursMin pgtype.Numeric
+	EstimatedHoursMax pgtype.Numeric
+	CapAmount         pgtype.Numeric
+	DepositAmount     pgtype.Numeric
+}
+
+func quotePricingColumnsOf(quote *repository.Quote) quotePricingColumns {
+	return quotePricingColumns{
+		PricingModel:      quote.PricingModel,
+		Amount:            quote.Amount,
+		AgreedAmount:      quote.AgreedAmount,
+		HourlyRate:        quote.HourlyRate,
+		EstimatedHoursMin: quote.EstimatedHoursMin,
+		EstimatedHoursMax: quote.EstimatedHoursMax,
+		CapAmount:         quote.CapAmount,
+		DepositAmount:     quote.DepositAmount,
+	}
+}
+
+// quotePricingToResponse describes the pricing of a quote with its estimated
+// totals and a one-line summary, e.g. "SGD 250.00/hour, 10-20 hours estimated
+// (SGD 2500.00-5000.00), capped at SGD 4000.00, SGD 500.00 deposit".
+func quotePricingToResponse(columns quotePricingColumns) *dto.QuotePricingResponse {
+	model := columns.PricingModel
+	if model == "" {
+		model = pricingFixed
+	}
+
+	hourlyRate := utils.PgtypeNumericToDecimal(columns.HourlyRate)
+	hoursMin := utils.PgtypeNumericToDecimal(columns.EstimatedHoursMin)
+	hoursMax := utils.PgtypeNumericToDecimal(columns.EstimatedHoursMax)
+	if !isHourlyPricing(model) || hourlyRate == nil || hoursMin == nil || hoursMax == nil {
+		fee := getDecimalOrZero(quoteChargeAmount(&repository.Quote{Amount: columns.Amount, AgreedAmount: columns.AgreedAmount}))
+		return &dto.QuotePricingResponse{
+			Model:             model,
+			EstimatedTotalMin: fee,
+			EstimatedTotalMax: fee,
+			Summary:           fmt.Sprintf("SGD %s fixed fee", fee.StringFixed(2)),
+		}
+	}
+
+	capAmount := utils.PgtypeNumericToDecimal(columns.CapAmount)
+	depositAmount := utils.PgtypeNumericToDecimal(columns.DepositAmount)
+	totalMin := hourlyRate.Mul(*hoursMin).Round(2)
+	totalMax := hourlyRate.Mul(*hoursMax).Round(2)
+	if capAmount != nil {
+		totalMin = decimal.Min(totalMin, *capAmount)
+		totalMax = decimal.Min(totalMax, *capAmount)
+	}
+
+	summary := fmt.Sprintf("SGD %s/hour, %s-%s hours estimated (SGD %s-%s)",
+		hourlyRate.StringFixed(2), hoursMin.String(), hoursMax.String(), totalMin.StringFixed(2), totalMax.StringFixed(2))
+	if capAmount != nil {
+		summary += fmt.Sprintf(", capped at SGD %s", capAmount.StringFixed(2))
+	}
+	if depositAmount != nil {
+		summary += fmt.Sprintf(", SGD %s deposit", depositAmount.StringFixed(2))
+	}
+
+	return &dto.QuotePricingResponse{
+		Model:             model,
+		HourlyRate:        hourlyRate,
+		EstimatedHoursMin: hoursMin,
+		EstimatedHoursMax: hoursMax,
+		CapAmount:         capAmount,
+		DepositAmount:     depositAmount,
+		EstimatedTotalMin: totalMin,
+		EstimatedTotalMax: totalMax,
+		Summary:           summary,
+	}
+}
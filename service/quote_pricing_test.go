@@ -0,0 +1,178 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-modules/utils"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/shopspring/decimal"
+)
+
+func TestParseQuotePricing(t *testing.T) {
+	hourly := func(rate, hoursMin, hoursMax, deposit string) dto.SubmitQuoteRequest {
+		return dto.SubmitQuoteRequest{
+			PricingModel:      pricingHourly,
+			HourlyRate:        rate,
+			EstimatedHoursMin: hoursMin,
+			EstimatedHoursMax: hoursMax,
+			DepositAmount:     deposit,
+		}
+	}
+	capped := func(rate, hoursMin, hoursMax, capAmount, deposit string) dto.SubmitQuoteRequest {
+		req := hourly(rate, hoursMin, hoursMax, deposit)
+		req.PricingModel = pricingCapped
+		req.CapAmount = capAmount
+		return req
+	}
+
+	tests := []struct {
+		name       string
+		req        dto.SubmitQuoteRequest
+		wantModel  string
+		wantAmount string
+		wantErr    string
+	}{
+		{name: "fixed by default", req: dto.SubmitQuoteRequest{Amount: "1500"}, wantModel: pricingFixed, wantAmount: "1500"},
+		{name: "fixed without amount", req: dto.SubmitQuoteRequest{PricingModel: pricingFixed}, wantErr: "amount is required"},
+		{name: "fixed with zero amount", req: dto.SubmitQuoteRequest{Amount: "0"}, wantErr: "amount must be greater than zero"},
+		{name: "fixed with hourly fields", req: dto.SubmitQuoteRequest{Amount: "1500", HourlyRate: "250"}, wantErr: "only apply to hourly and capped quotes"},
+
+		{name: "hourly amount is the maximum total", req: hourly("250", "10", "20", "500"), wantModel: pricingHourly, wantAmount: "5000"},
+		{name: "hourly total uses the rate in cents", req: hourly("33.33", "1", "3", "50"), wantModel: pricingHourly, wantAmount: "99.99"},
+		{name: "hourly total is rounded to cents", req: hourly("33.33", "1", "1.5", "20"), wantModel: pricingHourly, wantAmount: "50"},
+		{name: "hourly rate with trailing zeros", req: hourly("250.000", "10", "20", "500"), wantModel: pricingHourly, wantAmount: "5000"},
+		{name: "hourly rate below a cent", req: hourly("33.333", "1", "3", "50"), wantErr: "hourly_rate can have at most 2 decimal places"},
+		{name: "hourly hours below a hundredth", req: hourly("250", "1", "2.125", "50"), wantErr: "estimated_hours_max can have at most 2 decimal places"},
+		{name: "deposit below a cent", req: hourly("250", "10", "20", "500.005"), wantErr: "deposit_amount can have at most 2 decimal places"},
+		{name: "hourly with an amount", req: func() dto.SubmitQuoteRequest { r := hourly("250", "10", "20", "500"); r.Amount = "5000"; return r }(), wantErr: "calculated from the hourly rate"},
+		{name: "hourly with milestones", req: func() dto.SubmitQuoteRequest {
+			r := hourly("250", "10", "20", "500")
+			r.Milestones = []dto.QuoteMilestoneRequest{{Title: "Drafting", Amount: "5000"}}
+			return r
+		}(), wantErr: "milestones only apply to fixed quotes"},
+		{name: "hourly with a cap", req: func() dto.SubmitQuoteRequest { r := hourly("250", "10", "20", "500"); r.CapAmount = "4000"; return r }(), wantErr: "cap_amount only applies to capped quotes"},
+		{name: "hourly without a rate", req: hourly("", "10", "20", "500"), wantErr: "hourly_rate is required"},
+		{name: "hourly with a negative rate", req: hourly("-250", "10", "20", "500"), wantErr: "hourly_rate must be greater than zero"},
+		{name: "hourly maximum below minimum", req: hourly("250", "20", "10", "500"), wantErr: "estimated_hours_max cannot be less than estimated_hours_min"},
+		{name: "hourly without a deposit", req: hourly("250", "10", "20", ""), wantErr: "deposit_amount is required"},
+		{name: "hourly deposit equal to the maximum", req: hourly("100", "1", "2", "200"), wantModel: pricingHourly, wantAmount: "200"},
+		{name: "hourly deposit above the maximum", req: hourly("100", "1", "2", "200.01"), wantErr: "deposit_amount cannot exceed the estimated maximum total"},
+
+		{name: "capped amount is the cap below the maximum", req: capped("250", "10", "20", "4000", "500"), wantModel: pricingCapped, wantAmount: "4000"},
+		{name: "capped amount is the maximum below the cap", req: capped("250", "10", "20", "6000", "500"), wantModel: pricingCapped, wantAmount: "5000"},
+		{name: "capped cap equal to the minimum", req: capped("250", "10", "20", "2500", "500"), wantModel: pricingCapped, wantAmount: "2500"},
+		{name: "capped cap below the minimum", req: capped("250", "10", "20", "2499.99", "500"), wantErr: "cap_amount cannot be less than the estimated minimum total"},
+		{name: "capped without a cap", req: capped("250", "10", "20", "", "500"), wantErr: "cap_amount is required"},
+		{name: "capped deposit above the cap", req: capped("250", "10", "20", "4000", "4000.01"), wantErr: "deposit_amount cannot exceed the estimated maximum total"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pricing, err := parseQuotePricing(tt.req)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseQuotePricing() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseQuotePricing() returned error: %v", err)
+			}
+
+			if pricing.model != tt.wantModel {
+				t.Errorf("model = %q, want %q", pricing.model, tt.wantModel)
+			}
+			if want := decimal.RequireFromString(tt.wantAmount); !pricing.amount.Equal(want) {
+				t.Errorf("amount = %s, want %s", pricing.amount, want)
+			}
+		})
+	}
+}
+
+func TestQuotePricingToResponse(t *testing.T) {
+	numeric := func(value string) pgtype.Numeric {
+		return utils.DecimalToPgtypeNumeric(decimal.RequireFromString(value))
+	}
+
+	tests := []struct {
+		name      string
+		columns   quotePricingColumns
+		wantModel string
+		wantMin   string
+		wantMax   string
+		wantSum   string
+	}{
+		{
+			name:      "fixed fee",
+			columns:   quotePricingColumns{PricingModel: pricingFixed, Amount: numeric("1500")},
+			wantModel: pricingFixed, wantMin: "1500", wantMax: "1500",
+			wantSum: "SGD 1500.00 fixed fee",
+		},
+		{
+			name:      "quotes from before pricing models are fixed",
+			columns:   quotePricingColumns{Amount: numeric("800.5")},
+			wantModel: pricingFixed, wantMin: "800.5", wantMax: "800.5",
+			wantSum: "SGD 800.50 fixed fee",
+		},
+		{
+			name:      "fixed fee with agreed counter-offer",
+			columns:   quotePricingColumns{PricingModel: pricingFixed, Amount: numeric("1500"), AgreedAmount: numeric("1200")},
+			wantModel: pricingFixed, wantMin: "1200", wantMax: "1200",
+			wantSum: "SGD 1200.00 fixed fee",
+		},
+		{
+			name: "hourly",
+			columns: quotePricingColumns{PricingModel: pricingHourly, Amount: numeric("5000"), HourlyRate: numeric("250"),
+				EstimatedHoursMin: numeric("10"), EstimatedHoursMax: numeric("20"), DepositAmount: numeric("500")},
+			wantModel: pricingHourly, wantMin: "2500", wantMax: "5000",
+			wantSum: "SGD 250.00/hour, 10-20 hours estimated (SGD 2500.00-5000.00), SGD 500.00 deposit",
+		},
+		{
+			name: "hourly totals are rounded to cents",
+			columns: quotePricingColumns{PricingModel: pricingHourly, Amount: numeric("99.99"), HourlyRate: numeric("33.33"),
+				EstimatedHoursMin: numeric("1.5"), EstimatedHoursMax: numeric("3"), DepositAmount: numeric("50")},
+			wantModel: pricingHourly, wantMin: "50", wantMax: "99.99",
+			wantSum: "SGD 33.33/hour, 1.5-3 hours estimated (SGD 50.00-99.99), SGD 50.00 deposit",
+		},
+		{
+			name: "capped below the maximum",
+			columns: quotePricingColumns{PricingModel: pricingCapped, Amount: numeric("4000"), HourlyRate: numeric("250"),
+				EstimatedHoursMin: numeric("10"), EstimatedHoursMax: numeric("20"), CapAmount: numeric("4000"), DepositAmount: numeric("500")},
+			wantModel: pricingCapped, wantMin: "2500", wantMax: "4000",
+			wantSum: "SGD 250.00/hour, 10-20 hours estimated (SGD 2500.00-4000.00), capped at SGD 4000.00, SGD 500.00 deposit",
+		},
+		{
+			name: "capped above the maximum",
+			columns: quotePricingColumns{PricingModel: pricingCapped, Amount: numeric("5000"), HourlyRate: numeric("250"),
+				EstimatedHoursMin: numeric("10"), EstimatedHoursMax: numeric("20"), CapAmount: numeric("6000"), DepositAmount: numeric("500")},
+			wantModel: pricingCapped, wantMin: "2500", wantMax: "5000",
+			wantSum: "SGD 250.00/hour, 10-20 hours estimated (SGD 2500.00-5000.00), capped at SGD 6000.00, SGD 500.00 deposit",
+		},
+		{
+			name:      "hourly without an estimate falls back to the amount",
+			columns:   quotePricingColumns{PricingModel: pricingHourly, Amount: numeric("900"), HourlyRate: numeric("300")},
+			wantModel: pricingHourly, wantMin: "900", wantMax: "900",
+			wantSum: "SGD 900.00 fixed fee",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := quotePricingToResponse(tt.columns)
+			if got.Model != tt.wantModel {
+				t.Errorf("Model = %q, want %q", got.Model, tt.wantModel)
+			}
+			if want := decimal.RequireFromString(tt.wantMin); !got.EstimatedTotalMin.Equal(want) {
+				t.Errorf("EstimatedTotalMin = %s, want %s", got.EstimatedTotalMin, want)
+			}
+			if want := decimal.RequireFromString(tt.wantMax); !got.EstimatedTotalMax.Equal(want) {
+				t.Errorf("EstimatedTotalMax = %s, want %s", got.EstimatedTotalMax, want)
+			}
+			if got.Summary != tt.wantSum {
+				t.Errorf("Summary = %q, want %q", got.Summary, tt.wantSum)
+			}
+		})
+	}
+}
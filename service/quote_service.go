@@ -7,19 +7,26 @@ import (
 
 	"github.com/gadhittana01/cases-app-server/db/repository"
 	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/lifecycle"
 	"github.com/gadhittana01/cases-modules/utils"
+	dbUtils "github.com/gadhittana01/cases-modules/utils"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	pusher "github.com/pusher/pusher-http-go/v5"
 	"github.com/shopspring/decimal"
 )
 
 type QuoteService struct {
-	repo repository.Repository
+	repo         repository.Repository
+	pusherClient *pusher.Client
+	expiry       QuoteExpiryConfig
 }
 
-func NewQuoteService(repo repository.Repository) *QuoteService {
+func NewQuoteService(repo repository.Repository, pusherClient *pusher.Client, expiry QuoteExpiryConfig) *QuoteService {
 	return &QuoteService{
-		repo: repo,
+		repo:         repo,
+		pusherClient: pusherClient,
+		expiry:       expiry,
 	}
 }
 
@@ -29,10 +36,28 @@ func (s *QuoteService) CreateQuote(ctx context.Context, caseID, lawyerID uuid.UU
 	if err != nil {
 		return nil, fmt.Errorf("case not found: %w", err)
 	}
-	if caseRecord.Status != "open" {
+	if !lifecycle.AcceptsQuotes(caseRecord.Status) {
 		return nil, fmt.Errorf("case is not open for quotes")
 	}
 
+	verified, err := isLawyerVerified(ctx, s.repo, lawyerID)
+	if err != nil {
+		return nil, err
+	}
+	if !verified {
+		return nil, fmt.Errorf("your lawyer verification must be approved before you can submit quotes")
+	}
+
+	if caseRecord.Jurisdiction.Valid {
+		lawyer, err := s.repo.GetUserByID(ctx, lawyerID)
+		if err != nil {
+			return nil, fmt.Errorf("user not found: %w", err)
+		}
+		if !sameJurisdiction(lawyer.Jurisdiction.String, caseRecord.Jurisdiction.String) {
+			return nil, fmt.Errorf("this case is in %s and you are not admitted there", caseRecord.Jurisdiction.String)
+		}
+	}
+
 
 	existingQuote, err := s.repo.GetQuoteByCaseAndLawyer(ctx, &repository.GetQuoteByCaseAndLawyerParams{
 		CaseID:   caseID,
@@ -46,38 +71,72 @@ func (s *QuoteService) CreateQuote(ctx context.Context, caseID, lawyerID uuid.UU
 	}
 
 
-	amount, err := decimal.NewFromString(req.Amount)
+	pricing, err := parseQuotePricing(req)
 	if err != nil {
-		return nil, fmt.Errorf("invalid amount format: %w", err)
+		return nil, err
 	}
+	amount := pricing.amount
 
+	milestoneTerms, err := parseMilestones(req.Milestones, amount)
+	if err != nil {
+		return nil, err
+	}
 
-	quote, err := s.repo.CreateQuote(ctx, &repository.CreateQuoteParams{
-		CaseID:       caseID,
-		LawyerID:     lawyerID,
-		Amount:       utils.DecimalToPgtypeNumeric(amount),
-		ExpectedDays: int32(req.ExpectedDays),
-		Note:         utils.ToPgtypeText(&req.Note),
-		Status:       "proposed",
-	})
+	validUntil, err := s.quoteValidUntil(req.ValidUntil)
 	if err != nil {
+		return nil, err
+	}
+
+
+	var quote *repository.Quote
+	var milestones []*repository.QuoteMilestone
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		quote, err = txRepo.CreateQuote(ctx, &repository.CreateQuoteParams{
+			CaseID:            caseID,
+			LawyerID:          lawyerID,
+			Amount:            utils.DecimalToPgtypeNumeric(amount),
+			ExpectedDays:      int32(req.ExpectedDays),
+			Note:              utils.ToPgtypeText(&req.Note),
+			Status:            "proposed",
+			ValidUntil:        validUntil,
+			PricingModel:      pricing.model,
+			HourlyRate:        nullableNumeric(pricing.hourlyRate),
+			EstimatedHoursMin: nullableNumeric(pricing.estimatedHoursMin),
+			EstimatedHoursMax: nullableNumeric(pricing.estimatedHoursMax),
+			CapAmount:         nullableNumeric(pricing.capAmount),
+			DepositAmount:     nullableNumeric(pricing.depositAmount),
+		})
+		if err != nil {
 
-		if err.Error() != "" {
-			return nil, fmt.Errorf("failed to create quote: %w", err)
+			if err.Error() != "" {
+				return fmt.Errorf("failed to create quote: %w", err)
+			}
+			return fmt.Errorf("you have already submitted a quote for this case")
 		}
-		return nil, fmt.Errorf("you have already submitted a quote for this case")
+
+		milestones, err = saveQuoteMilestones(ctx, txRepo, quote.ID, milestoneTerms)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return s.quoteToResponse(quote), nil
+	response := quoteToResponse(quote)
+	response.Milestones = quoteMilestonesToResponse(milestones)
+	return response, nil
 }
 
+// UpdateQuote changes the terms of a quote. The previous terms are kept as a
+// revision so the client can see how the quote changed.
 func (s *QuoteService) UpdateQuote(ctx context.Context, caseID, lawyerID uuid.UUID, req dto.SubmitQuoteRequest) (*dto.QuoteResponse, error) {
 
 	caseRecord, err := s.repo.GetCaseByID(ctx, caseID)
 	if err != nil {
 		return nil, fmt.Errorf("case not found: %w", err)
 	}
-	if caseRecord.Status != "open" {
+	if !lifecycle.AcceptsQuotes(caseRecord.Status) {
 		return nil, fmt.Errorf("case is not open for quotes")
 	}
 
@@ -97,43 +156,336 @@ func (s *QuoteService) UpdateQuote(ctx context.Context, caseID, lawyerID uuid.UU
 	if existingQuote.Status == "accepted" {
 		return nil, fmt.Errorf("quote already accepted, cannot update")
 	}
+	if existingQuote.Status == "voided" {
+		return nil, fmt.Errorf("quote was voided by an administrator, cannot update")
+	}
+	if existingQuote.Status == "withdrawn" {
+		return nil, fmt.Errorf("quote was withdrawn, cannot update")
+	}
+	if existingQuote.Status == "declined" && !existingQuote.RebidAllowed {
+		return nil, fmt.Errorf("the client declined this quote, you can resubmit once they reopen bidding for you")
+	}
+
+
+	pricing, err := parseQuotePricing(req)
+	if err != nil {
+		return nil, err
+	}
+	amount := pricing.amount
+
+
+	milestoneTerms, err := parseMilestones(req.Milestones, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	existingMilestones, err := s.repo.GetQuoteMilestones(ctx, existingQuote.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quote milestones: %w", err)
+	}
+
+	previousAmount := getDecimalOrZero(utils.PgtypeNumericToDecimal(existingQuote.Amount))
+	if previousAmount.Equal(amount) && samePricing(existingQuote, pricing) && int(existingQuote.ExpectedDays) == req.ExpectedDays && existingQuote.Note.String == req.Note &&
+		sameMilestones(existingMilestones, milestoneTerms) && req.ValidUntil == nil && !s.expiry.isExpired(existingQuote) {
+		response := quoteToResponse(existingQuote)
+		response.Milestones = quoteMilestonesToResponse(existingMilestones)
+		return response, nil
+	}
+
+	// Changed terms are a fresh offer, so the validity window restarts.
+	validUntil, err := s.quoteValidUntil(req.ValidUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	var quote *repository.Quote
+	var milestones []*repository.QuoteMilestone
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		// The locked row is what this update replaces, so concurrent updates
+		// snapshot and number their revisions one after the other.
+		previous, err := txRepo.GetQuoteByIDForUpdate(ctx, existingQuote.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get quote: %w", err)
+		}
+
+		// Accepting the quote locks the same row before it records a pending
+		// payment, so the client is never charged for terms that changed.
+		pendingPayments, err := txRepo.CountPendingPaymentsByQuoteID(ctx, existingQuote.ID)
+		if err != nil {
+			return fmt.Errorf("failed to check pending payments: %w", err)
+		}
+		if pendingPayments > 0 {
+			return fmt.Errorf("the client is paying for this quote, its terms can no longer change")
+		}
+
+		milestones, err = txRepo.GetQuoteMilestones(ctx, existingQuote.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get quote milestones: %w", err)
+		}
+		previousMilestones, err := marshalRevisionMilestones(milestones)
+		if err != nil {
+			return err
+		}
+
+		latest, err := txRepo.GetLatestQuoteRevisionNumber(ctx, existingQuote.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get latest quote revision: %w", err)
+		}
+
+		quote, err = txRepo.UpdateQuote(ctx, &repository.UpdateQuoteParams{
+			ID:                existingQuote.ID,
+			Amount:            utils.DecimalToPgtypeNumeric(amount),
+			ExpectedDays:      int32(req.ExpectedDays),
+			Note:              utils.ToPgtypeText(&req.Note),
+			ValidUntil:        validUntil,
+			PricingModel:      pricing.model,
+			HourlyRate:        nullableNumeric(pricing.hourlyRate),
+			EstimatedHoursMin: nullableNumeric(pricing.estimatedHoursMin),
+			EstimatedHoursMax: nullableNumeric(pricing.estimatedHoursMax),
+			CapAmount:         nullableNumeric(pricing.capAmount),
+			DepositAmount:     nullableNumeric(pricing.depositAmount),
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("quote can no longer be updated, it is %s", previous.Status)
+			}
+			return fmt.Errorf("failed to update quote: %w", err)
+		}
+
+		// New terms replace whatever was being negotiated.
+		if err := txRepo.SupersedePendingQuoteOffers(ctx, existingQuote.ID); err != nil {
+			return fmt.Errorf("failed to close pending offers: %w", err)
+		}
+
+		if !sameMilestones(milestones, milestoneTerms) {
+			milestones, err = saveQuoteMilestones(ctx, txRepo, existingQuote.ID, milestoneTerms)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = txRepo.CreateQuoteRevision(ctx, &repository.CreateQuoteRevisionParams{
+			QuoteID:           existingQuote.ID,
+			Revision:          latest + 1,
+			Amount:            previous.Amount,
+			ExpectedDays:      previous.ExpectedDays,
+			Note:              previous.Note,
+			PricingModel:      previous.PricingModel,
+			HourlyRate:        previous.HourlyRate,
+			EstimatedHoursMin: previous.EstimatedHoursMin,
+			EstimatedHoursMax: previous.EstimatedHoursMax,
+			CapAmount:         previous.CapAmount,
+			DepositAmount:     previous.DepositAmount,
+			Milestones:        previousMilestones,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save quote revision: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := quoteToResponse(quote)
+	response.Milestones = quoteMilestonesToResponse(milestones)
+	return response, nil
+}
+
+// WithdrawQuote lets a lawyer retract their proposed quote and tells the
+// client. Once the client has a pending payment for the quote it can no
+// longer be withdrawn.
+func (s *QuoteService) WithdrawQuote(ctx context.Context, caseID, lawyerID uuid.UUID) (*dto.QuoteResponse, error) {
+	caseRecord, err := s.repo.GetCaseByID(ctx, caseID)
+	if err != nil {
+		return nil, fmt.Errorf("case not found: %w", err)
+	}
+
+	existingQuote, err := s.repo.GetQuoteByCaseAndLawyer(ctx, &repository.GetQuoteByCaseAndLawyerParams{
+		CaseID:   caseID,
+		LawyerID: lawyerID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("quote not found")
+		}
+		return nil, fmt.Errorf("failed to get existing quote: %w", err)
+	}
+	if existingQuote.Status != "proposed" {
+		return nil, fmt.Errorf("only proposed quotes can be withdrawn, this quote is %s", existingQuote.Status)
+	}
+
+	var quote *repository.Quote
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		// Accepting the quote locks the same row before it records a pending
+		// payment, so the payment check below cannot go stale.
+		if _, err := txRepo.GetQuoteByIDForUpdate(ctx, existingQuote.ID); err != nil {
+			return fmt.Errorf("failed to get quote: %w", err)
+		}
+
+		pendingPayments, err := txRepo.CountPendingPaymentsByQuoteID(ctx, existingQuote.ID)
+		if err != nil {
+			return fmt.Errorf("failed to check pending payments: %w", err)
+		}
+		if pendingPayments > 0 {
+			return fmt.Errorf("the client is paying for this quote, it can no longer be withdrawn")
+		}
+
+		quote, err = txRepo.WithdrawQuote(ctx, existingQuote.ID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("quote can no longer be withdrawn")
+			}
+			return fmt.Errorf("failed to withdraw quote: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifyUser(s.pusherClient, caseRecord.ClientID, "quote-withdrawn", map[string]interface{}{
+		"case_id":    caseID.String(),
+		"case_title": caseRecord.Title,
+		"quote_id":   quote.ID.String(),
+		"lawyer_id":  lawyerID.String(),
+	})
+
+	return quoteToResponse(quote), nil
+}
+
+// DeclineQuote lets the client turn down a single proposed quote while the
+// case stays open for other lawyers. The lawyer is told why, if the client
+// gave a reason, and cannot resubmit until the client reopens bidding.
+func (s *QuoteService) DeclineQuote(ctx context.Context, quoteID, clientID uuid.UUID, reason string) (*dto.QuoteResponse, error) {
+	existingQuote, caseRecord, err := s.getClientQuote(ctx, quoteID, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !lifecycle.AcceptsQuotes(caseRecord.Status) {
+		return nil, fmt.Errorf("case is not open for quotes")
+	}
+	if existingQuote.Status != "proposed" {
+		return nil, fmt.Errorf("only proposed quotes can be declined, this quote is %s", existingQuote.Status)
+	}
+
+	var quote *repository.Quote
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		// Accepting the quote locks the same row before it records a pending
+		// payment, so the payment check below cannot go stale.
+		if _, err := txRepo.GetQuoteByIDForUpdate(ctx, quoteID); err != nil {
+			return fmt.Errorf("failed to get quote: %w", err)
+		}
+
+		pendingPayments, err := txRepo.CountPendingPaymentsByQuoteID(ctx, quoteID)
+		if err != nil {
+			return fmt.Errorf("failed to check pending payments: %w", err)
+		}
+		if pendingPayments > 0 {
+			return fmt.Errorf("you have a payment in progress for this quote")
+		}
+
+		quote, err = txRepo.DeclineQuote(ctx, &repository.DeclineQuoteParams{
+			ID:            quoteID,
+			DeclineReason: utils.ToPgtypeText(optionalString(reason)),
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("quote can no longer be declined")
+			}
+			return fmt.Errorf("failed to decline quote: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
+	notifyUser(s.pusherClient, quote.LawyerID, "quote-declined", map[string]interface{}{
+		"case_id":        caseRecord.ID.String(),
+		"case_title":     caseRecord.Title,
+		"quote_id":       quote.ID.String(),
+		"decline_reason": quote.DeclineReason.String,
+	})
+
+	return quoteToResponse(quote), nil
+}
 
-	amount, err := decimal.NewFromString(req.Amount)
+// ReopenBidding lets the lawyer of a declined quote submit new terms.
+func (s *QuoteService) ReopenBidding(ctx context.Context, quoteID, clientID uuid.UUID) (*dto.QuoteResponse, error) {
+	existingQuote, caseRecord, err := s.getClientQuote(ctx, quoteID, clientID)
 	if err != nil {
-		return nil, fmt.Errorf("invalid amount format: %w", err)
+		return nil, err
+	}
+	if !lifecycle.AcceptsQuotes(caseRecord.Status) {
+		return nil, fmt.Errorf("case is not open for quotes")
+	}
+	if existingQuote.Status != "declined" {
+		return nil, fmt.Errorf("only declined quotes can be reopened for bidding")
 	}
 
+	quote, err := s.repo.AllowQuoteRebid(ctx, quoteID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reopen bidding: %w", err)
+	}
 
-	quote, err := s.repo.UpdateQuote(ctx, &repository.UpdateQuoteParams{
-		ID:           existingQuote.ID,
-		Amount:       utils.DecimalToPgtypeNumeric(amount),
-		ExpectedDays: int32(req.ExpectedDays),
-		Note:         utils.ToPgtypeText(&req.Note),
+	notifyUser(s.pusherClient, quote.LawyerID, "quote-rebid-allowed", map[string]interface{}{
+		"case_id":    caseRecord.ID.String(),
+		"case_title": caseRecord.Title,
+		"quote_id":   quote.ID.String(),
 	})
+
+	return quoteToResponse(quote), nil
+}
+
+// getClientQuote loads a quote together with its case, making sure the case
+// belongs to the client.
+func (s *QuoteService) getClientQuote(ctx context.Context, quoteID, clientID uuid.UUID) (*repository.Quote, *repository.Case, error) {
+	quote, err := s.repo.GetQuoteByID(ctx, quoteID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update quote: %w", err)
+		return nil, nil, fmt.Errorf("quote not found: %w", err)
 	}
 
-	return s.quoteToResponse(quote), nil
+	caseRecord, err := s.repo.GetCaseByID(ctx, quote.CaseID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("case not found: %w", err)
+	}
+	if caseRecord.ClientID != clientID {
+		return nil, nil, fmt.Errorf("unauthorized: you can only manage quotes on your own cases")
+	}
+
+	return quote, caseRecord, nil
 }
 
-func (s *QuoteService) quoteToResponse(quote *repository.Quote) *dto.QuoteResponse {
+func quoteToResponse(quote *repository.Quote) *dto.QuoteResponse {
 	amountDecimal := utils.PgtypeNumericToDecimal(quote.Amount)
 	if amountDecimal == nil {
 		amountDecimal = &decimal.Zero
 	}
 
 	return &dto.QuoteResponse{
-		ID:           quote.ID,
-		CaseID:       quote.CaseID,
-		LawyerID:     quote.LawyerID,
-		Amount:       *amountDecimal,
-		ExpectedDays: int(quote.ExpectedDays),
-		Note:         utils.GetStringOrEmpty(utils.GetNullableString(quote.Note)),
-		Status:       quote.Status,
-		CreatedAt:    utils.PgtypeTimeToTime(quote.CreatedAt),
-		UpdatedAt:    utils.PgtypeTimeToTime(quote.UpdatedAt),
+		ID:            quote.ID,
+		CaseID:        quote.CaseID,
+		LawyerID:      quote.LawyerID,
+		Amount:        *amountDecimal,
+		ExpectedDays:  int(quote.ExpectedDays),
+		Note:          utils.GetStringOrEmpty(utils.GetNullableString(quote.Note)),
+		Status:        quote.Status,
+		CreatedAt:     utils.PgtypeTimeToTime(quote.CreatedAt),
+		UpdatedAt:     utils.PgtypeTimeToTime(quote.UpdatedAt),
+		ValidUntil:    nullableTime(quote.ValidUntil),
+		DeclineReason: utils.GetNullableString(quote.DeclineReason),
+		RebidAllowed:  quote.RebidAllowed,
+		AgreedAmount:  utils.PgtypeNumericToDecimal(quote.AgreedAmount),
+		AgreedDays:    nullableInt(quote.AgreedDays),
+		Pricing:       quotePricingToResponse(quotePricingColumnsOf(quote)),
 	}
 }
 
@@ -149,10 +501,19 @@ func (s *QuoteService) GetQuoteByCaseAndLawyer(ctx context.Context, caseID, lawy
 		return nil, fmt.Errorf("failed to get quote: %w", err)
 	}
 
-	return s.quoteToResponse(quote), nil
+	milestones, err := s.repo.GetQuoteMilestones(ctx, quote.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quote milestones: %w", err)
+	}
+
+	response := quoteToResponse(quote)
+	response.Milestones = quoteMilestonesToResponse(milestones)
+	return response, nil
 }
 
-func (s *QuoteService) GetQuotesByLawyerID(ctx context.Context, lawyerID uuid.UUID, status string, page, pageSize int) ([]dto.QuoteResponse, int64, error) {
+// GetQuotesByLawyerID returns a page of the lawyer's quotes, newest first. A
+// non-empty cursor switches to keyset pagination, which skips the total count.
+func (s *QuoteService) GetQuotesByLawyerID(ctx context.Context, lawyerID uuid.UUID, status string, page, pageSize int, cursorToken string) ([]dto.QuoteResponse, int64, string, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -161,27 +522,50 @@ func (s *QuoteService) GetQuotesByLawyerID(ctx context.Context, lawyerID uuid.UU
 	}
 	offset := (page - 1) * pageSize
 
+	cursor, err := decodeCursor(cursorToken)
+	if err != nil {
+		return nil, 0, "", err
+	}
+
 	statusFilter := ""
 	if status != "" {
 		statusFilter = status
 	}
 
-	quotes, err := s.repo.GetQuotesByLawyerID(ctx, &repository.GetQuotesByLawyerIDParams{
+	params := &repository.GetQuotesByLawyerIDParams{
 		LawyerID: lawyerID,
 		Column2:  statusFilter,
-		Limit:    int32(pageSize),
+		Limit:    int32(pageSize + 1),
 		Offset:   int32(offset),
-	})
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get quotes: %w", err)
+	}
+	if cursor != nil {
+		params.Offset = 0
+		params.Column5 = true
+		params.Column6 = cursor.CreatedAt
+		params.Column7 = cursor.ID
 	}
 
-	total, err := s.repo.CountQuotesByLawyerID(ctx, &repository.CountQuotesByLawyerIDParams{
-		LawyerID: lawyerID,
-		Column2:  statusFilter,
-	})
+	quotes, err := s.repo.GetQuotesByLawyerID(ctx, params)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to count quotes: %w", err)
+		return nil, 0, "", fmt.Errorf("failed to get quotes: %w", err)
+	}
+
+	var nextCursor string
+	if len(quotes) > pageSize {
+		quotes = quotes[:pageSize]
+		last := quotes[len(quotes)-1]
+		nextCursor = encodeCursor(pageCursor{CreatedAt: utils.PgtypeTimeToTime(last.CreatedAt), ID: last.ID})
+	}
+
+	var total int64
+	if cursor == nil {
+		total, err = s.repo.CountQuotesByLawyerID(ctx, &repository.CountQuotesByLawyerIDParams{
+			LawyerID: lawyerID,
+			Column2:  statusFilter,
+		})
+		if err != nil {
+			return nil, 0, "", fmt.Errorf("failed to count quotes: %w", err)
+		}
 	}
 
 	result := make([]dto.QuoteResponse, 0, len(quotes))
@@ -191,21 +575,38 @@ func (s *QuoteService) GetQuotesByLawyerID(ctx context.Context, lawyerID uuid.UU
 			amountDecimal = &decimal.Zero
 		}
 
+		pricing := quotePricingToResponse(quotePricingColumns{
+			PricingModel:      quote.PricingModel,
+			Amount:            quote.Amount,
+			AgreedAmount:      quote.AgreedAmount,
+			HourlyRate:        quote.HourlyRate,
+			EstimatedHoursMin: quote.EstimatedHoursMin,
+			EstimatedHoursMax: quote.EstimatedHoursMax,
+			CapAmount:         quote.CapAmount,
+			DepositAmount:     quote.DepositAmount,
+		})
+
 		result = append(result, dto.QuoteResponse{
-			ID:           quote.ID,
-			CaseID:       quote.CaseID,
-			LawyerID:     quote.LawyerID,
-			Amount:       *amountDecimal,
-			ExpectedDays: int(quote.ExpectedDays),
-			Note:         utils.GetStringOrEmpty(utils.GetNullableString(quote.Note)),
-			Status:       quote.Status,
-			CreatedAt:    utils.PgtypeTimeToTime(quote.CreatedAt),
-			UpdatedAt:    utils.PgtypeTimeToTime(quote.UpdatedAt),
-			CaseTitle:    &quote.CaseTitle,
+			ID:            quote.ID,
+			CaseID:        quote.CaseID,
+			LawyerID:      quote.LawyerID,
+			Amount:        *amountDecimal,
+			ExpectedDays:  int(quote.ExpectedDays),
+			Note:          utils.GetStringOrEmpty(utils.GetNullableString(quote.Note)),
+			Status:        quote.Status,
+			CreatedAt:     utils.PgtypeTimeToTime(quote.CreatedAt),
+			UpdatedAt:     utils.PgtypeTimeToTime(quote.UpdatedAt),
+			ValidUntil:    nullableTime(quote.ValidUntil),
+			DeclineReason: utils.GetNullableString(quote.DeclineReason),
+			RebidAllowed:  quote.RebidAllowed,
+			AgreedAmount:  utils.PgtypeNumericToDecimal(quote.AgreedAmount),
+			AgreedDays:    nullableInt(quote.AgreedDays),
+			Pricing:       pricing,
+			CaseTitle:     &quote.CaseTitle,
 		})
 	}
 
-	return result, int64(total), nil
+	return result, total, nextCursor, nil
 }
 
 
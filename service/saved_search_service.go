@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gadhittana01/cases-app-server/db/repository"
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-modules/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	pusher "github.com/pusher/pusher-http-go/v5"
+)
+
+const (
+	maxSavedSearchesPerLawyer = 20
+	savedSearchMatchTimeout   = 30 * time.Second
+	savedSearchAlertQueueSize = 100
+	// savedSearchInlineAlerts bounds the alert matches running at once when
+	// no worker is draining the queue.
+	savedSearchInlineAlerts = 4
+)
+
+type SavedSearchService struct {
+	repo         repository.Repository
+	pusherClient *pusher.Client
+	alerts       chan uuid.UUID
+	inline       chan struct{}
+	workerActive atomic.Bool
+}
+
+func NewSavedSearchService(repo repository.Repository, pusherClient *pusher.Client) *SavedSearchService {
+	return &SavedSearchService{
+		repo:         repo,
+		pusherClient: pusherClient,
+		alerts:       make(chan uuid.UUID, savedSearchAlertQueueSize),
+		inline:       make(chan struct{}, savedSearchInlineAlerts),
+	}
+}
+
+func (s *SavedSearchService) CreateSavedSearch(ctx context.Context, lawyerID uuid.UUID, req dto.SavedSearchRequest) (*dto.SavedSearchResponse, error) {
+	category := optionalString(req.Category)
+	query := optionalString(req.Query)
+	jurisdiction := optionalString(req.Jurisdiction)
+	if category == nil && query == nil && jurisdiction == nil {
+		return nil, errors.New("a saved search needs at least one of category, q or jurisdiction")
+	}
+	if category != nil {
+		if err := validateCategory(ctx, s.repo, *category); err != nil {
+			return nil, err
+		}
+	}
+
+	count, err := s.repo.CountSavedSearchesByLawyerID(ctx, lawyerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count saved searches: %w", err)
+	}
+	if count >= maxSavedSearchesPerLawyer {
+		return nil, fmt.Errorf("you can keep at most %d saved searches", maxSavedSearchesPerLawyer)
+	}
+
+	savedSearch, err := s.repo.CreateSavedSearch(ctx, &repository.CreateSavedSearchParams{
+		LawyerID:     lawyerID,
+		Name:         strings.TrimSpace(req.Name),
+		Category:     utils.ToPgtypeText(category),
+		Query:        utils.ToPgtypeText(query),
+		Jurisdiction: utils.ToPgtypeText(jurisdiction),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create saved search: %w", err)
+	}
+
+	return savedSearchToResponse(savedSearch), nil
+}
+
+func (s *SavedSearchService) ListSavedSearches(ctx context.Context, lawyerID uuid.UUID) ([]dto.SavedSearchResponse, error) {
+	savedSearches, err := s.repo.ListSavedSearchesByLawyerID(ctx, lawyerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved searches: %w", err)
+	}
+
+	result := make([]dto.SavedSearchResponse, 0, len(savedSearches))
+	for _, savedSearch := range savedSearches {
+		result = append(result, *savedSearchToResponse(savedSearch))
+	}
+
+	return result, nil
+}
+
+func (s *SavedSearchService) DeleteSavedSearch(ctx context.Context, lawyerID, savedSearchID uuid.UUID) error {
+	if _, err := s.repo.DeleteSavedSearch(ctx, &repository.DeleteSavedSearchParams{
+		ID:       savedSearchID,
+		LawyerID: lawyerID,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("saved search not found")
+		}
+		return fmt.Errorf("failed to delete saved search: %w", err)
+	}
+	return nil
+}
+
+// QueueAlerts sends saved search alerts for a newly created case without
+// waiting on them. With a worker running they are queued for it; otherwise,
+// as on serverless deployments, they are matched in a goroutine of their own,
+// at most savedSearchInlineAlerts at a time. When neither has room the
+// alerts for this case are dropped.
+func (s *SavedSearchService) QueueAlerts(caseID uuid.UUID) {
+	if s.workerActive.Load() {
+		select {
+		case s.alerts <- caseID:
+		default:
+			log.Printf("Saved search alert queue is full, dropping alerts for case %s", caseID)
+		}
+		return
+	}
+
+	select {
+	case s.inline <- struct{}{}:
+		go func() {
+			defer func() { <-s.inline }()
+			s.AlertMatchingSearches(context.Background(), caseID)
+		}()
+	default:
+		log.Printf("Too many saved search alerts in flight, dropping alerts for case %s", caseID)
+	}
+}
+
+// RunAlertWorker sends the queued saved search alerts, one case at a time,
+// until ctx is done.
+func (s *SavedSearchService) RunAlertWorker(ctx context.Context) {
+	s.workerActive.Store(true)
+	defer s.workerActive.Store(false)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case caseID := <-s.alerts:
+			s.AlertMatchingSearches(ctx, caseID)
+		}
+	}
+}
+
+// AlertMatchingSearches notifies every lawyer with a saved search the new case
+// matches, at most once per lawyer.
+func (s *SavedSearchService) AlertMatchingSearches(ctx context.Context, caseID uuid.UUID) {
+	ctx, cancel := context.WithTimeout(ctx, savedSearchMatchTimeout)
+	defer cancel()
+
+	caseRecord, err := s.repo.GetCaseByID(ctx, caseID)
+	if err != nil {
+		log.Printf("Failed to load case %s for saved search alerts: %v", caseID, err)
+		return
+	}
+
+	matches, err := s.repo.ListSavedSearchesMatchingCase(ctx, caseID)
+	if err != nil {
+		log.Printf("Failed to match saved searches for case %s: %v", caseID, err)
+		return
+	}
+
+	for _, savedSearch := range matches {
+		if err := createNotification(ctx, s.repo, s.pusherClient, savedSearch.LawyerID, NotificationSavedSearchMatch, map[string]interface{}{
+			"saved_search_id":   savedSearch.ID,
+			"saved_search_name": savedSearch.Name,
+			"case_id":           caseRecord.ID,
+			"case_title":        caseRecord.Title,
+			"category":          caseRecord.Category,
+		}); err != nil {
+			log.Printf("Failed to alert lawyer %s about case %s: %v", savedSearch.LawyerID, caseID, err)
+		}
+	}
+}
+
+func savedSearchToResponse(savedSearch *repository.SavedSearch) *dto.SavedSearchResponse {
+	return &dto.SavedSearchResponse{
+		ID:           savedSearch.ID,
+		Name:         savedSearch.Name,
+		Category:     utils.GetNullableString(savedSearch.Category),
+		Query:        utils.GetNullableString(savedSearch.Query),
+		Jurisdiction: utils.GetNullableString(savedSearch.Jurisdiction),
+		CreatedAt:    utils.PgtypeTimeToTime(savedSearch.CreatedAt),
+	}
+}
+
+// optionalString trims value and returns nil when nothing is left.
+func optionalString(value string) *string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+	return &value
+}
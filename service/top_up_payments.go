@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/lifecycle"
+	"github.com/gadhittana01/cases-modules/utils"
+	dbUtils "github.com/gadhittana01/cases-modules/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/shopspring/decimal"
+)
+
+// RequestTopUp bills the client for hours the lawyer worked on an engaged
+// hourly or capped quote, beyond what the deposit and earlier top-ups covered.
+// Capped quotes cannot be billed past their cap.
+func (s *PaymentService) RequestTopUp(ctx context.Context, quoteID, lawyerID uuid.UUID, req dto.TopUpRequest) (*dto.TopUpResponse, error) {
+	quote, err := s.repo.GetQuoteByID(ctx, quoteID)
+	if err != nil {
+		return nil, fmt.Errorf("quote not found: %w", err)
+	}
+	if quote.LawyerID != lawyerID {
+		return nil, fmt.Errorf("unauthorized: you can only bill your own quotes")
+	}
+	if !isHourlyPricing(quote.PricingModel) {
+		return nil, fmt.Errorf("only hourly and capped quotes can be topped up")
+	}
+
+	caseRecord, err := s.repo.GetCaseByID(ctx, quote.CaseID)
+	if err != nil {
+		return nil, fmt.Errorf("case not found: %w", err)
+	}
+	if quote.Status != "accepted" || caseRecord.Status != lifecycle.CaseEngaged {
+		return nil, fmt.Errorf("top-ups can only be requested while you are engaged on the case")
+	}
+
+	hours, err := parsePositiveDecimal("hours", req.Hours)
+	if err != nil {
+		return nil, err
+	}
+	hourlyRate := utils.PgtypeNumericToDecimal(quote.HourlyRate)
+	if hourlyRate == nil {
+		return nil, fmt.Errorf("quote has no hourly rate")
+	}
+	amount := hourlyRate.Mul(*hours).Round(2)
+
+	var payment *dto.PaymentIntentResponse
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		// Every payment for the quote is requested under this lock, so two
+		// top-ups cannot both pass the pending and cap checks.
+		if _, err := txRepo.GetQuoteByIDForUpdate(ctx, quoteID); err != nil {
+			return fmt.Errorf("failed to get quote: %w", err)
+		}
+
+		pendingPayments, err := txRepo.CountPendingPaymentsByQuoteID(ctx, quoteID)
+		if err != nil {
+			return fmt.Errorf("failed to check pending payments: %w", err)
+		}
+		if pendingPayments > 0 {
+			return fmt.Errorf("the client has a payment pending for this quote")
+		}
+
+		if capAmount := utils.PgtypeNumericToDecimal(quote.CapAmount); capAmount != nil {
+			total, err := txRepo.SumActivePaymentsByQuoteID(ctx, quoteID)
+			if err != nil {
+				return fmt.Errorf("failed to sum payments: %w", err)
+			}
+			billed := getDecimalOrZero(utils.PgtypeNumericToDecimal(total))
+			if err := checkTopUpCap(*capAmount, billed, amount); err != nil {
+				return err
+			}
+		}
+
+		payment, err = s.createPaymentLink(ctx, txRepo, quote, caseRecord.Title, amount, "top_up", nil)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	notifyUser(s.pusherClient, caseRecord.ClientID, "top-up-requested", map[string]interface{}{
+		"case_id":          caseRecord.ID.String(),
+		"case_title":       caseRecord.Title,
+		"quote_id":         quoteID.String(),
+		"hours":            hours.String(),
+		"amount":           amount.String(),
+		"note":             req.Note,
+		"payment_link_url": payment.PaymentLinkURL,
+	})
+
+	return &dto.TopUpResponse{
+		Hours:   *hours,
+		Amount:  amount,
+		Payment: *payment,
+	}, nil
+}
+
+// checkTopUpCap makes sure a top-up keeps the total billed on a capped quote,
+// deposit included, within its cap.
+func checkTopUpCap(capAmount, billed, amount decimal.Decimal) error {
+	if total := billed.Add(amount); total.GreaterThan(capAmount) {
+		return fmt.Errorf("this top-up would bring the total billed to SGD %s, above the cap of SGD %s",
+			total.StringFixed(2), capAmount.StringFixed(2))
+	}
+	return nil
+}
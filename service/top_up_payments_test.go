@@ -0,0 +1,39 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestCheckTopUpCap(t *testing.T) {
+	tests := []struct {
+		name    string
+		billed  string
+		amount  string
+		wantErr string
+	}{
+		{name: "deposit only, well under the cap", billed: "500", amount: "1000"},
+		{name: "reaches the cap exactly", billed: "3000", amount: "1000"},
+		{name: "one cent past the cap", billed: "3000", amount: "1000.01", wantErr: "total billed to SGD 4000.01, above the cap of SGD 4000.00"},
+		{name: "already at the cap", billed: "4000", amount: "0.01", wantErr: "above the cap"},
+		{name: "nothing billed yet", billed: "0", amount: "4500", wantErr: "total billed to SGD 4500.00"},
+	}
+
+	capAmount := decimal.RequireFromString("4000")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkTopUpCap(capAmount, decimal.RequireFromString(tt.billed), decimal.RequireFromString(tt.amount))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("checkTopUpCap() returned error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("checkTopUpCap() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
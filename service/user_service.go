@@ -2,31 +2,61 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	"github.com/gadhittana01/cases-app-server/db/repository"
 	"github.com/gadhittana01/cases-app-server/dto"
+	"github.com/gadhittana01/cases-app-server/mailer"
+	"github.com/gadhittana01/cases-modules/middleware"
 	"github.com/gadhittana01/cases-modules/utils"
+	dbUtils "github.com/gadhittana01/cases-modules/utils"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"golang.org/x/crypto/bcrypt"
 )
 
+const (
+	accessTokenTTL        = 15 * time.Minute
+	refreshTokenTTL       = 30 * 24 * time.Hour
+	passwordResetTokenTTL = 1 * time.Hour
+
+	emailVerificationTokenTTL    = 48 * time.Hour
+	emailVerificationResendDelay = 1 * time.Minute
+	emailVerificationHourlyLimit = 5
+)
+
+var (
+	ErrTooManyRequests        = errors.New("too many requests, please try again later")
+	ErrAccountSuspended       = errors.New("account has been suspended")
+	ErrInvalidCurrentPassword = errors.New("current password is incorrect")
+)
+
 type UserService struct {
-	repo      repository.Repository
-	jwtSecret string
+	repo        repository.Repository
+	jwtSecret   string
+	frontendURL string
+	mailer      mailer.Mailer
 }
 
-func NewUserService(repo repository.Repository, config *utils.Config) *UserService {
+func NewUserService(repo repository.Repository, config *utils.Config, mailer mailer.Mailer) *UserService {
 	jwtSecret := config.JWTSecret
 	return &UserService{
-		repo:      repo,
-		jwtSecret: jwtSecret,
+		repo:        repo,
+		jwtSecret:   jwtSecret,
+		frontendURL: config.FrontendURL,
+		mailer:      mailer,
 	}
 }
 
-func (s *UserService) Signup(ctx context.Context, req dto.SignupRequest) (*dto.AuthResponse, error) {
+func (s *UserService) Signup(ctx context.Context, req dto.SignupRequest, userAgent, ipAddress string) (*dto.AuthResponse, error) {
 	_, err := s.repo.GetUserByEmail(ctx, req.Email)
 	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
 		return nil, fmt.Errorf("failed to check existing user: %w", err)
@@ -50,38 +80,46 @@ func (s *UserService) Signup(ctx context.Context, req dto.SignupRequest) (*dto.A
 		barNumber = &req.BarNumber
 	}
 
-	user, err := s.repo.CreateUser(ctx, &repository.CreateUserParams{
-		Email:        req.Email,
-		PasswordHash: string(hashedPassword),
-		Name:         utils.ToPgtypeText(&req.Name),
-		Role:         req.Role,
-		Jurisdiction: utils.ToPgtypeText(jurisdiction),
-		BarNumber:    utils.ToPgtypeText(barNumber),
+	var user *repository.User
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		user, err = txRepo.CreateUser(ctx, &repository.CreateUserParams{
+			Email:        req.Email,
+			PasswordHash: string(hashedPassword),
+			Name:         utils.ToPgtypeText(&req.Name),
+			Role:         req.Role,
+			Jurisdiction: utils.ToPgtypeText(jurisdiction),
+			BarNumber:    utils.ToPgtypeText(barNumber),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create user: %w", err)
+		}
+
+		if user.Role == "lawyer" {
+			if _, err := txRepo.CreateLawyerVerification(ctx, &repository.CreateLawyerVerificationParams{
+				LawyerID:     user.ID,
+				Jurisdiction: user.Jurisdiction,
+				BarNumber:    user.BarNumber,
+			}); err != nil {
+				return fmt.Errorf("failed to create lawyer verification: %w", err)
+			}
+		}
+
+		return nil
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create user: %w", err)
+		return nil, err
 	}
 
-	token, err := utils.GenerateJWT(user.ID, user.Email, user.Role, s.jwtSecret)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
+	if err := s.sendVerificationEmail(ctx, user); err != nil {
+		log.Printf("Failed to send verification email to user %s: %v", user.ID, err)
 	}
 
-	return &dto.AuthResponse{
-		Token: token,
-		User: dto.UserResponse{
-			ID:           user.ID,
-			Email:        user.Email,
-			Name:         utils.GetStringOrEmpty(utils.GetNullableString(user.Name)),
-			Role:         user.Role,
-			Jurisdiction: jurisdiction,
-			BarNumber:    barNumber,
-			CreatedAt:    utils.PgtypeTimeToTime(user.CreatedAt),
-		},
-	}, nil
+	return s.issueTokens(ctx, user, userAgent, ipAddress)
 }
 
-func (s *UserService) Login(ctx context.Context, req dto.LoginRequest) (*dto.AuthResponse, error) {
+func (s *UserService) Login(ctx context.Context, req dto.LoginRequest, userAgent, ipAddress string) (*dto.AuthResponse, error) {
 	user, err := s.repo.GetUserByEmail(ctx, req.Email)
 	if err != nil {
 		return nil, errors.New("invalid email or password")
@@ -92,40 +130,480 @@ func (s *UserService) Login(ctx context.Context, req dto.LoginRequest) (*dto.Aut
 		return nil, errors.New("invalid email or password")
 	}
 
-	token, err := utils.GenerateJWT(user.ID, user.Email, user.Role, s.jwtSecret)
+	if user.SuspendedAt.Valid {
+		return nil, ErrAccountSuspended
+	}
+
+	return s.issueTokens(ctx, user, userAgent, ipAddress)
+}
+
+// errRefreshTokenReused reports a refresh token presented after it was
+// rotated. Only a replayed or stolen token is used twice, so the session it
+// belongs to is revoked.
+var errRefreshTokenReused = errors.New("refresh token already used, please log in again")
+
+// RefreshToken exchanges a refresh token for a new access token and rotates
+// the refresh token, so each refresh token can only be used once.
+func (s *UserService) RefreshToken(ctx context.Context, refreshToken string) (*dto.AuthResponse, error) {
+	tokenHash := hashToken(refreshToken)
+
+	session, err := s.repo.GetSessionByRefreshTokenHash(ctx, tokenHash)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
+		if errors.Is(err, pgx.ErrNoRows) {
+			if sessionID, err := s.repo.GetSessionIDByRotatedRefreshTokenHash(ctx, tokenHash); err == nil {
+				return nil, s.revokeReusedSession(ctx, sessionID)
+			}
+		}
+		return nil, errors.New("invalid refresh token")
+	}
+	if session.RevokedAt.Valid || time.Now().After(session.ExpiresAt) {
+		return nil, errors.New("refresh token expired or revoked")
 	}
 
-	var jurisdiction *string
-	var barNumber *string
-	if user.Jurisdiction.Valid {
-		jurisdiction = &user.Jurisdiction.String
+	user, err := s.repo.GetUserByID(ctx, session.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
 	}
-	if user.BarNumber.Valid {
-		barNumber = &user.BarNumber.String
+	if user.SuspendedAt.Valid {
+		return nil, ErrAccountSuspended
+	}
+
+	newRefreshToken, err := generateSecureToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	sessionID := session.ID
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		var err error
+		session, err = txRepo.RotateSessionRefreshToken(ctx, &repository.RotateSessionRefreshTokenParams{
+			NewRefreshTokenHash: hashToken(newRefreshToken),
+			ExpiresAt:           time.Now().Add(refreshTokenTTL),
+			ID:                  sessionID,
+			RefreshTokenHash:    tokenHash,
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				// A concurrent request rotated the same token first.
+				return errRefreshTokenReused
+			}
+			return fmt.Errorf("failed to rotate refresh token: %w", err)
+		}
+
+		if err := txRepo.CreateRotatedRefreshToken(ctx, &repository.CreateRotatedRefreshTokenParams{
+			TokenHash: tokenHash,
+			SessionID: sessionID,
+		}); err != nil {
+			return fmt.Errorf("failed to record rotated refresh token: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, errRefreshTokenReused) {
+			return nil, s.revokeReusedSession(ctx, sessionID)
+		}
+		return nil, err
+	}
+
+	accessToken, expiresAt, err := s.generateAccessToken(user, session.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
 
 	return &dto.AuthResponse{
-		Token: token,
-		User: dto.UserResponse{
-			ID:           user.ID,
-			Email:        user.Email,
-			Name:         utils.GetStringOrEmpty(utils.GetNullableString(user.Name)),
-			Role:         user.Role,
-			Jurisdiction: jurisdiction,
-			BarNumber:    barNumber,
-			CreatedAt:    utils.PgtypeTimeToTime(user.CreatedAt),
-		},
+		Token:        accessToken,
+		RefreshToken: newRefreshToken,
+		ExpiresAt:    expiresAt,
+		User:         userToResponse(user),
 	}, nil
 }
 
+// revokeReusedSession revokes the session of a refresh token that was used
+// twice and returns errRefreshTokenReused.
+func (s *UserService) revokeReusedSession(ctx context.Context, sessionID uuid.UUID) error {
+	if err := s.repo.RevokeSession(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return errRefreshTokenReused
+}
+
+func (s *UserService) Logout(ctx context.Context, sessionID uuid.UUID) error {
+	if err := s.repo.RevokeSession(ctx, sessionID); err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	return nil
+}
+
+// ForgotPassword emails a single-use reset link. It succeeds for unknown
+// emails too, so the endpoint cannot be used to discover accounts.
+func (s *UserService) ForgotPassword(ctx context.Context, email string) error {
+	user, err := s.repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	token, err := generateSecureToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate reset token: %w", err)
+	}
+
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		if err := txRepo.InvalidateUserPasswordResetTokens(ctx, user.ID); err != nil {
+			return fmt.Errorf("failed to invalidate previous reset tokens: %w", err)
+		}
+
+		if _, err := txRepo.CreatePasswordResetToken(ctx, &repository.CreatePasswordResetTokenParams{
+			UserID:    user.ID,
+			TokenHash: hashToken(token),
+			ExpiresAt: time.Now().Add(passwordResetTokenTTL),
+		}); err != nil {
+			return fmt.Errorf("failed to create reset token: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	resetURL := fmt.Sprintf("%s/reset-password?token=%s", s.frontendURL, token)
+	err = s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body:    fmt.Sprintf("We received a request to reset your password.\n\nOpen the link below within %d minutes to choose a new one:\n%s\n\nIf you did not request this, you can ignore this email.", int(passwordResetTokenTTL.Minutes()), resetURL),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send reset email: %w", err)
+	}
+
+	return nil
+}
+
+// ResetPassword consumes a reset token, sets the new password and signs the
+// user out everywhere.
+func (s *UserService) ResetPassword(ctx context.Context, req dto.ResetPasswordRequest) error {
+	resetToken, err := s.repo.GetPasswordResetTokenByHash(ctx, hashToken(req.Token))
+	if err != nil {
+		return errors.New("invalid or expired reset token")
+	}
+	if resetToken.UsedAt.Valid || time.Now().After(resetToken.ExpiresAt) {
+		return errors.New("invalid or expired reset token")
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	return dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		if _, err := txRepo.MarkPasswordResetTokenUsed(ctx, resetToken.ID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return errors.New("invalid or expired reset token")
+			}
+			return fmt.Errorf("failed to mark reset token used: %w", err)
+		}
+
+		if err := txRepo.UpdateUserPassword(ctx, &repository.UpdateUserPasswordParams{
+			ID:           resetToken.UserID,
+			PasswordHash: string(hashedPassword),
+		}); err != nil {
+			return fmt.Errorf("failed to update password: %w", err)
+		}
+
+		if err := txRepo.RevokeUserSessions(ctx, resetToken.UserID); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (s *UserService) VerifyEmail(ctx context.Context, token string) error {
+	verificationToken, err := s.repo.GetEmailVerificationTokenByHash(ctx, hashToken(token))
+	if err != nil {
+		return errors.New("invalid or expired verification token")
+	}
+	if verificationToken.UsedAt.Valid || time.Now().After(verificationToken.ExpiresAt) {
+		return errors.New("invalid or expired verification token")
+	}
+
+	return dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		if _, err := txRepo.MarkEmailVerificationTokenUsed(ctx, verificationToken.ID); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return errors.New("invalid or expired verification token")
+			}
+			return fmt.Errorf("failed to mark verification token used: %w", err)
+		}
+
+		if _, err := txRepo.MarkUserEmailVerified(ctx, verificationToken.UserID); err != nil {
+			return fmt.Errorf("failed to verify email: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ResendVerificationEmail issues a fresh verification link. Requests are
+// limited to one per minute and emailVerificationHourlyLimit per hour.
+func (s *UserService) ResendVerificationEmail(ctx context.Context, userID uuid.UUID) error {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+	if user.EmailVerifiedAt.Valid {
+		return errors.New("email is already verified")
+	}
+
+	latest, err := s.repo.GetLatestEmailVerificationToken(ctx, userID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to check previous verification email: %w", err)
+	}
+	if err == nil && time.Since(utils.PgtypeTimeToTime(latest.CreatedAt)) < emailVerificationResendDelay {
+		return ErrTooManyRequests
+	}
+
+	sentLastHour, err := s.repo.CountEmailVerificationTokensSince(ctx, &repository.CountEmailVerificationTokensSinceParams{
+		UserID:    userID,
+		CreatedAt: utils.TimeToPgtypeTime(time.Now().Add(-time.Hour)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to count verification emails: %w", err)
+	}
+	if sentLastHour >= emailVerificationHourlyLimit {
+		return ErrTooManyRequests
+	}
+
+	return s.sendVerificationEmail(ctx, user)
+}
+
+func (s *UserService) sendVerificationEmail(ctx context.Context, user *repository.User) error {
+	token, err := generateSecureToken()
+	if err != nil {
+		return fmt.Errorf("failed to generate verification token: %w", err)
+	}
+
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		if err := txRepo.InvalidateUserEmailVerificationTokens(ctx, user.ID); err != nil {
+			return fmt.Errorf("failed to invalidate previous verification tokens: %w", err)
+		}
+
+		if _, err := txRepo.CreateEmailVerificationToken(ctx, &repository.CreateEmailVerificationTokenParams{
+			UserID:    user.ID,
+			TokenHash: hashToken(token),
+			ExpiresAt: time.Now().Add(emailVerificationTokenTTL),
+		}); err != nil {
+			return fmt.Errorf("failed to create verification token: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	verifyURL := fmt.Sprintf("%s/verify-email?token=%s", s.frontendURL, token)
+	err = s.mailer.Send(ctx, mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body:    fmt.Sprintf("Please confirm your email address by opening the link below:\n%s\n\nYou need a verified email address before you can post cases or submit quotes.", verifyURL),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to send verification email: %w", err)
+	}
+
+	return nil
+}
+
 func (s *UserService) GetUserByID(ctx context.Context, userID uuid.UUID) (*dto.UserResponse, error) {
 	user, err := s.repo.GetUserByID(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("user not found: %w", err)
 	}
 
+	response := userToResponse(user)
+	return &response, nil
+}
+
+// UpdateProfile applies a partial profile update; omitted fields keep their
+// current value. A lawyer who changes their jurisdiction or bar number goes
+// back through verification.
+func (s *UserService) UpdateProfile(ctx context.Context, userID uuid.UUID, req dto.UpdateProfileRequest) (*dto.UserResponse, error) {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	if user.Role != "lawyer" && (req.Jurisdiction != nil || req.BarNumber != nil) {
+		return nil, errors.New("only lawyers can set a jurisdiction or bar number")
+	}
+	if isBlank(req.Name) || isBlank(req.Jurisdiction) || isBlank(req.BarNumber) {
+		return nil, errors.New("name, jurisdiction and bar_number cannot be empty when provided")
+	}
+
+	credentialsChanged := (req.Jurisdiction != nil && *req.Jurisdiction != user.Jurisdiction.String) ||
+		(req.BarNumber != nil && *req.BarNumber != user.BarNumber.String)
+
+	err = dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		user, err = txRepo.UpdateUser(ctx, &repository.UpdateUserParams{
+			ID:           userID,
+			Name:         utils.ToPgtypeText(req.Name),
+			Jurisdiction: utils.ToPgtypeText(req.Jurisdiction),
+			BarNumber:    utils.ToPgtypeText(req.BarNumber),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update profile: %w", err)
+		}
+
+		if !credentialsChanged {
+			return nil
+		}
+
+		latest, err := txRepo.GetLatestLawyerVerificationByLawyerID(ctx, userID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("failed to get verification: %w", err)
+		}
+		if err == nil && latest.Status == VerificationStatusPending {
+			if _, err := txRepo.UpdatePendingLawyerVerificationCredentials(ctx, &repository.UpdatePendingLawyerVerificationCredentialsParams{
+				ID:           latest.ID,
+				Jurisdiction: user.Jurisdiction,
+				BarNumber:    user.BarNumber,
+			}); err != nil {
+				return fmt.Errorf("failed to update verification: %w", err)
+			}
+			return nil
+		}
+
+		if _, err := txRepo.CreateLawyerVerification(ctx, &repository.CreateLawyerVerificationParams{
+			LawyerID:     userID,
+			Jurisdiction: user.Jurisdiction,
+			BarNumber:    user.BarNumber,
+		}); err != nil {
+			return fmt.Errorf("failed to create lawyer verification: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := userToResponse(user)
+	return &response, nil
+}
+
+// ChangePassword sets a new password after re-checking the current one and
+// signs out every session except the one making the request.
+func (s *UserService) ChangePassword(ctx context.Context, userID, sessionID uuid.UUID, req dto.ChangePasswordRequest) error {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(req.CurrentPassword)); err != nil {
+		return ErrInvalidCurrentPassword
+	}
+	if req.CurrentPassword == req.NewPassword {
+		return errors.New("new password must be different from the current password")
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	return dbUtils.ExecTxPool(ctx, s.repo.GetDB(), func(tx pgx.Tx) error {
+		txRepo := s.repo.WithTx(tx)
+
+		if err := txRepo.UpdateUserPassword(ctx, &repository.UpdateUserPasswordParams{
+			ID:           userID,
+			PasswordHash: string(hashedPassword),
+		}); err != nil {
+			return fmt.Errorf("failed to update password: %w", err)
+		}
+
+		if err := txRepo.RevokeOtherUserSessions(ctx, &repository.RevokeOtherUserSessionsParams{
+			UserID: userID,
+			ID:     sessionID,
+		}); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+
+		if err := txRepo.InvalidateUserPasswordResetTokens(ctx, userID); err != nil {
+			return fmt.Errorf("failed to invalidate reset tokens: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (s *UserService) issueTokens(ctx context.Context, user *repository.User, userAgent, ipAddress string) (*dto.AuthResponse, error) {
+	refreshToken, err := generateSecureToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	session, err := s.repo.CreateSession(ctx, &repository.CreateSessionParams{
+		UserID:           user.ID,
+		RefreshTokenHash: hashToken(refreshToken),
+		UserAgent:        utils.ToPgtypeText(&userAgent),
+		IpAddress:        utils.ToPgtypeText(&ipAddress),
+		ExpiresAt:        time.Now().Add(refreshTokenTTL),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+
+	accessToken, expiresAt, err := s.generateAccessToken(user, session.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return &dto.AuthResponse{
+		Token:        accessToken,
+		RefreshToken: refreshToken,
+		ExpiresAt:    expiresAt,
+		User:         userToResponse(user),
+	}, nil
+}
+
+// generateAccessToken signs a short-lived JWT carrying the session ID as its
+// jti, which AuthMiddleware uses to reject tokens of revoked sessions.
+func (s *UserService) generateAccessToken(user *repository.User, sessionID uuid.UUID) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(accessTokenTTL)
+	claims := &middleware.Claims{
+		UserID: user.ID.String(),
+		Role:   user.Role,
+		Email:  user.Email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        sessionID.String(),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.jwtSecret))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
+}
+
+func userToResponse(user *repository.User) dto.UserResponse {
 	var jurisdiction *string
 	var barNumber *string
 	if user.Jurisdiction.Valid {
@@ -135,13 +613,37 @@ func (s *UserService) GetUserByID(ctx context.Context, userID uuid.UUID) (*dto.U
 		barNumber = &user.BarNumber.String
 	}
 
-	return &dto.UserResponse{
-		ID:           user.ID,
-		Email:        user.Email,
-		Name:         utils.GetStringOrEmpty(utils.GetNullableString(user.Name)),
-		Role:         user.Role,
-		Jurisdiction: jurisdiction,
-		BarNumber:    barNumber,
-		CreatedAt:    utils.PgtypeTimeToTime(user.CreatedAt),
-	}, nil
+	response := dto.UserResponse{
+		ID:               user.ID,
+		Email:            user.Email,
+		Name:             utils.GetStringOrEmpty(utils.GetNullableString(user.Name)),
+		Role:             user.Role,
+		Jurisdiction:     jurisdiction,
+		BarNumber:        barNumber,
+		EmailVerified:    user.EmailVerifiedAt.Valid,
+		SuspensionReason: utils.GetNullableString(user.SuspensionReason),
+		CreatedAt:        utils.PgtypeTimeToTime(user.CreatedAt),
+	}
+	if user.SuspendedAt.Valid {
+		response.SuspendedAt = &user.SuspendedAt.Time
+	}
+
+	return response
+}
+
+func generateSecureToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func isBlank(value *string) bool {
+	return value != nil && strings.TrimSpace(*value) == ""
 }
@@ -18,12 +18,20 @@ func InitializeApp(db utils.PGXPool, config *utils.Config) (*App, error) {
 		NewS3Client,
 		NewPresignClient,
 		NewPusherClient,
+		NewMailer,
+		NewRedactor,
+		NewQuoteExpiryConfig,
 		service.NewUserService,
 		service.NewCaseService,
 		service.NewQuoteService,
 		service.NewMarketplaceService,
 		service.NewPaymentService,
 		service.NewFileService,
+		service.NewLawyerVerificationService,
+		service.NewAdminService,
+		service.NewCategoryService,
+		service.NewSavedSearchService,
+		service.NewNotificationService,
 		handler.NewUserHandler,
 		handler.NewCaseHandler,
 		handler.NewQuoteHandler,
@@ -31,6 +39,11 @@ func InitializeApp(db utils.PGXPool, config *utils.Config) (*App, error) {
 		handler.NewPaymentHandler,
 		handler.NewFileHandler,
 		handler.NewWebhookHandler,
+		handler.NewLawyerVerificationHandler,
+		handler.NewAdminHandler,
+		handler.NewCategoryHandler,
+		handler.NewSavedSearchHandler,
+		handler.NewNotificationHandler,
 		routes.SetupRoutes,
 		NewApp,
 	)
@@ -19,9 +19,16 @@ import (
 
 func InitializeApp(db utils.PGXPool, config *utils.Config) (*App, error) {
 	repositoryRepository := repository.NewRepository(db)
-	userService := service.NewUserService(repositoryRepository, config)
+	mailerMailer := providers.NewMailer()
+	userService := service.NewUserService(repositoryRepository, config, mailerMailer)
 	userHandler := handler.NewUserHandler(userService)
-	caseService := service.NewCaseService(repositoryRepository)
+	pusherClient := providers.NewPusherClient(config)
+	savedSearchService := service.NewSavedSearchService(repositoryRepository, pusherClient)
+	redactor, err := providers.NewRedactor()
+	if err != nil {
+		return nil, err
+	}
+	caseService := service.NewCaseService(repositoryRepository, pusherClient, savedSearchService, redactor)
 	client, err := providers.NewS3Client(config)
 	if err != nil {
 		return nil, err
@@ -29,16 +36,28 @@ func InitializeApp(db utils.PGXPool, config *utils.Config) (*App, error) {
 	presignClient := providers.NewPresignClient(client)
 	fileService := service.NewFileService(repositoryRepository, client, presignClient, config)
 	caseHandler := handler.NewCaseHandler(caseService, fileService)
-	quoteService := service.NewQuoteService(repositoryRepository)
+	quoteExpiryConfig, err := providers.NewQuoteExpiryConfig()
+	if err != nil {
+		return nil, err
+	}
+	quoteService := service.NewQuoteService(repositoryRepository, pusherClient, quoteExpiryConfig)
 	quoteHandler := handler.NewQuoteHandler(quoteService)
-	marketplaceService := service.NewMarketplaceService(repositoryRepository)
+	marketplaceService := service.NewMarketplaceService(repositoryRepository, redactor)
 	marketplaceHandler := handler.NewMarketplaceHandler(marketplaceService)
-	pusherClient := providers.NewPusherClient(config)
-	paymentService := service.NewPaymentService(repositoryRepository, config, pusherClient)
+	paymentService := service.NewPaymentService(repositoryRepository, config, pusherClient, quoteExpiryConfig)
 	paymentHandler := handler.NewPaymentHandler(paymentService)
 	fileHandler := handler.NewFileHandler(fileService)
 	webhookHandler := handler.NewWebhookHandler(paymentService, config)
-	engine := routes.SetupRoutes(userHandler, caseHandler, quoteHandler, marketplaceHandler, paymentHandler, fileHandler, webhookHandler, config)
-	app := NewApp(engine, config)
+	lawyerVerificationService := service.NewLawyerVerificationService(repositoryRepository, fileService)
+	lawyerVerificationHandler := handler.NewLawyerVerificationHandler(lawyerVerificationService)
+	adminService := service.NewAdminService(repositoryRepository)
+	adminHandler := handler.NewAdminHandler(adminService)
+	categoryService := service.NewCategoryService(repositoryRepository)
+	categoryHandler := handler.NewCategoryHandler(categoryService)
+	savedSearchHandler := handler.NewSavedSearchHandler(savedSearchService)
+	notificationService := service.NewNotificationService(repositoryRepository)
+	notificationHandler := handler.NewNotificationHandler(notificationService)
+	engine := routes.SetupRoutes(userHandler, caseHandler, quoteHandler, marketplaceHandler, paymentHandler, fileHandler, webhookHandler, lawyerVerificationHandler, adminHandler, categoryHandler, savedSearchHandler, notificationHandler, repositoryRepository, config)
+	app := NewApp(engine, config, caseService, quoteService, savedSearchService)
 	return app, nil
 }